@@ -0,0 +1,24 @@
+module github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidadapter/gofrsuuid
+
+go 1.21
+
+require (
+	github.com/gofrs/uuid/v5 v5.4.0
+	github.com/google/go-cmp v0.7.0
+	github.com/hashicorp/terraform-plugin-framework v1.8.0
+	github.com/matthewhartstonge/terraform-plugin-framework-type-uuid v0.0.0-00010101000000-000000000000
+)
+
+require (
+	github.com/fatih/color v1.13.0 // indirect
+	github.com/hashicorp/go-hclog v1.5.0 // indirect
+	github.com/hashicorp/terraform-plugin-go v0.22.2 // indirect
+	github.com/hashicorp/terraform-plugin-log v0.9.0 // indirect
+	github.com/mattn/go-colorable v0.1.12 // indirect
+	github.com/mattn/go-isatty v0.0.14 // indirect
+	github.com/vmihailenco/msgpack/v5 v5.4.1 // indirect
+	github.com/vmihailenco/tagparser/v2 v2.0.0 // indirect
+	golang.org/x/sys v0.17.0 // indirect
+)
+
+replace github.com/matthewhartstonge/terraform-plugin-framework-type-uuid => ../..
@@ -0,0 +1,20 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+// Package googleuuid converts between uuidtypes.UUIDValue and the UUID type of the
+// github.com/google/uuid module.
+//
+// The package is a separate Go module, so only providers importing it depend
+// on github.com/google/uuid. For example, reading a model value into an SDK request:
+//
+//	id, diags := googleuuid.To(plan.ID)
+//	resp.Diagnostics.Append(diags...)
+//
+// The pointer variants convert a nil pointer to a null UUIDValue, and null or
+// unknown values to a nil pointer.
+package googleuuid
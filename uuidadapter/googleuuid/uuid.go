@@ -0,0 +1,93 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package googleuuid
+
+import (
+	// External Imports
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidtypes"
+)
+
+// From converts a github.com/google/uuid UUID to a known UUIDValue in the canonical format.
+func From(value uuid.UUID) uuidtypes.UUIDValue {
+	return uuidtypes.NewUUIDValueFromBytes(value)
+}
+
+// FromPointer converts a github.com/google/uuid UUID pointer to a UUIDValue. A nil pointer
+// converts to a null value.
+func FromPointer(value *uuid.UUID) uuidtypes.UUIDValue {
+	if value == nil {
+		return uuidtypes.NewUUIDNull()
+	}
+
+	return From(*value)
+}
+
+// FromSlice converts a slice of github.com/google/uuid UUIDs to UUIDValues. A nil slice is
+// returned as nil.
+func FromSlice(values []uuid.UUID) []uuidtypes.UUIDValue {
+	if values == nil {
+		return nil
+	}
+
+	out := make([]uuidtypes.UUIDValue, len(values))
+	for i, value := range values {
+		out[i] = From(value)
+	}
+
+	return out
+}
+
+// To converts a known UUIDValue to a github.com/google/uuid UUID. Null and unknown values
+// convert to uuid.Nil. Diagnostics are returned if the value is not a UUID
+// accepted by the value's UUIDType.
+func To(value uuidtypes.UUIDValue) (uuid.UUID, diag.Diagnostics) {
+	out, diags := value.ValueUUID()
+
+	return out, diags
+}
+
+// ToPointer converts a UUIDValue to a github.com/google/uuid UUID pointer. Null and unknown
+// values convert to nil.
+func ToPointer(value uuidtypes.UUIDValue) (*uuid.UUID, diag.Diagnostics) {
+	out, diags := value.ValueUUIDPointer()
+	if out == nil {
+		return nil, diags
+	}
+
+	converted := uuid.UUID(*out)
+
+	return &converted, diags
+}
+
+// ToSlice converts a slice of UUIDValues to github.com/google/uuid UUIDs. Null and unknown
+// values convert to uuid.Nil. Diagnostics for each invalid value are returned
+// together. A nil slice is returned as nil.
+func ToSlice(values []uuidtypes.UUIDValue) ([]uuid.UUID, diag.Diagnostics) {
+	if values == nil {
+		return nil, nil
+	}
+
+	var diags diag.Diagnostics
+	out := make([]uuid.UUID, len(values))
+	for i, value := range values {
+		var valueDiags diag.Diagnostics
+		out[i], valueDiags = To(value)
+		diags.Append(valueDiags...)
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return out, diags
+}
@@ -0,0 +1,277 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package googleuuid_test
+
+import (
+	// Standard Library Imports
+	"testing"
+
+	// External Imports
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidadapter/googleuuid"
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidtypes"
+)
+
+const (
+	valueInvalid     = "actually-not-04a00-UUID-valueat0all0"
+	valueUUIDv4      = "eb6f148a-6637-4c6b-a4bb-b75b2a1b5a3c"
+	valueUUIDv4Upper = "EB6F148A-6637-4C6B-A4BB-B75B2A1B5A3C"
+	valueUUIDNil     = "00000000-0000-0000-0000-000000000000"
+)
+
+func TestFrom(t *testing.T) {
+	t.Parallel()
+
+	got := googleuuid.From(uuid.Must(uuid.Parse(valueUUIDv4Upper)))
+	expected := uuidtypes.NewUUIDValue(valueUUIDv4)
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("From()\ngot     : %v\nexpected: %v\ndiff    : %s\n", got, expected, diff)
+	}
+}
+
+func TestFromPointer(t *testing.T) {
+	t.Parallel()
+
+	value := uuid.Must(uuid.Parse(valueUUIDv4))
+
+	tests := []struct {
+		name     string
+		value    *uuid.UUID
+		expected uuidtypes.UUIDValue
+	}{
+		{
+			name:     "nil",
+			value:    nil,
+			expected: uuidtypes.NewUUIDNull(),
+		},
+		{
+			name:     "value",
+			value:    &value,
+			expected: uuidtypes.NewUUIDValue(valueUUIDv4),
+		},
+	}
+
+	for _, testcase := range tests {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := googleuuid.FromPointer(testcase.value)
+			if diff := cmp.Diff(got, testcase.expected); diff != "" {
+				t.Errorf("FromPointer()\ngot     : %v\nexpected: %v\ndiff    : %s\n", got, testcase.expected, diff)
+			}
+		})
+	}
+}
+
+func TestFromSlice(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		values   []uuid.UUID
+		expected []uuidtypes.UUIDValue
+	}{
+		{
+			name:     "nil",
+			values:   nil,
+			expected: nil,
+		},
+		{
+			name:     "empty",
+			values:   []uuid.UUID{},
+			expected: []uuidtypes.UUIDValue{},
+		},
+		{
+			name: "values",
+			values: []uuid.UUID{
+				uuid.Must(uuid.Parse(valueUUIDv4)),
+				uuid.Nil,
+			},
+			expected: []uuidtypes.UUIDValue{
+				uuidtypes.NewUUIDValue(valueUUIDv4),
+				uuidtypes.NewUUIDValue(valueUUIDNil),
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := googleuuid.FromSlice(testcase.values)
+			if diff := cmp.Diff(got, testcase.expected); diff != "" {
+				t.Errorf("FromSlice()\ngot     : %v\nexpected: %v\ndiff    : %s\n", got, testcase.expected, diff)
+			}
+		})
+	}
+}
+
+func TestTo(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		value         uuidtypes.UUIDValue
+		expected      uuid.UUID
+		expectedDiags bool
+	}{
+		{
+			name:     "null",
+			value:    uuidtypes.NewUUIDNull(),
+			expected: uuid.Nil,
+		},
+		{
+			name:     "unknown",
+			value:    uuidtypes.NewUUIDUnknown(),
+			expected: uuid.Nil,
+		},
+		{
+			name:     "value",
+			value:    uuidtypes.NewUUIDValue(valueUUIDv4Upper),
+			expected: uuid.Must(uuid.Parse(valueUUIDv4)),
+		},
+		{
+			name:          "value-invalid",
+			value:         uuidtypes.NewUUIDValue(valueInvalid),
+			expected:      uuid.Nil,
+			expectedDiags: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, gotDiags := googleuuid.To(testcase.value)
+			if diff := cmp.Diff(got, testcase.expected); diff != "" {
+				t.Errorf("To()\ngot     : %v\nexpected: %v\ndiff    : %s\n", got, testcase.expected, diff)
+			}
+
+			if gotDiags.HasError() != testcase.expectedDiags {
+				t.Errorf("To() diag.Diagnostics\ngot     : %v\nexpected error: %v\n", gotDiags, testcase.expectedDiags)
+			}
+		})
+	}
+}
+
+func TestToPointer(t *testing.T) {
+	t.Parallel()
+
+	value := uuid.Must(uuid.Parse(valueUUIDv4))
+
+	tests := []struct {
+		name          string
+		value         uuidtypes.UUIDValue
+		expected      *uuid.UUID
+		expectedDiags bool
+	}{
+		{
+			name:     "null",
+			value:    uuidtypes.NewUUIDNull(),
+			expected: nil,
+		},
+		{
+			name:     "unknown",
+			value:    uuidtypes.NewUUIDUnknown(),
+			expected: nil,
+		},
+		{
+			name:     "value",
+			value:    uuidtypes.NewUUIDValue(valueUUIDv4),
+			expected: &value,
+		},
+		{
+			name:          "value-invalid",
+			value:         uuidtypes.NewUUIDValue(valueInvalid),
+			expected:      nil,
+			expectedDiags: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, gotDiags := googleuuid.ToPointer(testcase.value)
+			if diff := cmp.Diff(got, testcase.expected); diff != "" {
+				t.Errorf("ToPointer()\ngot     : %v\nexpected: %v\ndiff    : %s\n", got, testcase.expected, diff)
+			}
+
+			if gotDiags.HasError() != testcase.expectedDiags {
+				t.Errorf("ToPointer() diag.Diagnostics\ngot     : %v\nexpected error: %v\n", gotDiags, testcase.expectedDiags)
+			}
+		})
+	}
+}
+
+func TestToSlice(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		values        []uuidtypes.UUIDValue
+		expected      []uuid.UUID
+		expectedDiags int
+	}{
+		{
+			name:     "nil",
+			values:   nil,
+			expected: nil,
+		},
+		{
+			name: "values",
+			values: []uuidtypes.UUIDValue{
+				uuidtypes.NewUUIDValue(valueUUIDv4),
+				uuidtypes.NewUUIDNull(),
+			},
+			expected: []uuid.UUID{
+				uuid.Must(uuid.Parse(valueUUIDv4)),
+				uuid.Nil,
+			},
+		},
+		{
+			name: "values-invalid",
+			values: []uuidtypes.UUIDValue{
+				uuidtypes.NewUUIDValue(valueInvalid),
+				uuidtypes.NewUUIDValue(valueUUIDv4),
+				uuidtypes.NewUUIDValue(valueInvalid + "0"),
+			},
+			expected:      nil,
+			expectedDiags: 2,
+		},
+	}
+
+	for _, testcase := range tests {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, gotDiags := googleuuid.ToSlice(testcase.values)
+			if diff := cmp.Diff(got, testcase.expected); diff != "" {
+				t.Errorf("ToSlice()\ngot     : %v\nexpected: %v\ndiff    : %s\n", got, testcase.expected, diff)
+			}
+
+			if gotDiags.ErrorsCount() != testcase.expectedDiags {
+				t.Errorf("ToSlice() diag.Diagnostics\ngot     : %v\nexpected errors: %d\n", gotDiags, testcase.expectedDiags)
+			}
+		})
+	}
+}
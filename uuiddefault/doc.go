@@ -0,0 +1,25 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+// Package uuiddefault provides schema default values for UUID attributes.
+//
+// The defaults implement defaults.String, so can be set as the Default of
+// either a plain schema.StringAttribute or one using the uuidtypes.UUIDType
+// custom type:
+//
+//	schema.StringAttribute{
+//	    CustomType: uuidtypes.UUIDType{},
+//	    Optional:   true,
+//	    Computed:   true,
+//	    Default:    uuiddefault.Static("eb6f148a-6637-4c6b-a4bb-b75b2a1b5a3c"),
+//	}
+//
+// Schema defaults can only set a value and can not read other attributes, so
+// a default derived from other attributes is provided by the
+// uuidplanmodifier.Namespaced plan modifier instead.
+package uuiddefault
@@ -0,0 +1,62 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuiddefault
+
+import (
+	// Standard Library Imports
+	"context"
+	"fmt"
+
+	// External Imports
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidtypes"
+)
+
+var _ defaults.String = staticDefault{}
+
+// staticDefault implements the default value.
+type staticDefault struct {
+	uuid string
+}
+
+// Description returns a human-readable description of the default value.
+func (d staticDefault) Description(_ context.Context) string {
+	return fmt.Sprintf("value defaults to %s", d.uuid)
+}
+
+// MarkdownDescription returns a markdown description of the default value.
+func (d staticDefault) MarkdownDescription(_ context.Context) string {
+	return fmt.Sprintf("value defaults to `%s`", d.uuid)
+}
+
+// DefaultString implements the default value logic.
+func (d staticDefault) DefaultString(_ context.Context, _ defaults.StringRequest, resp *defaults.StringResponse) {
+	resp.PlanValue = types.StringValue(d.uuid)
+}
+
+// Static returns a default value which sets the attribute to the given UUID.
+// The UUID may be in any format accepted by uuidtypes.ParseUUIDValue and is
+// defaulted in the canonical lowercase format.
+//
+// The UUID is validated when the schema is built, panicking if it is not a
+// valid UUID, so a mistyped default is caught by any test which loads the
+// schema rather than when a practitioner plans a resource.
+func Static(uuid string) defaults.String {
+	value, err := uuidtypes.ParseUUIDValue(uuid)
+	if err != nil {
+		panic(fmt.Sprintf("uuiddefault: Static(%q): %s", uuid, err))
+	}
+
+	return staticDefault{
+		uuid: value.ValueString(),
+	}
+}
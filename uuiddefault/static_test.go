@@ -0,0 +1,104 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuiddefault_test
+
+import (
+	// Standard Library Imports
+	"context"
+	"testing"
+
+	// External Imports
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuiddefault"
+)
+
+const (
+	valueUUIDv4      = "eb6f148a-6637-4c6b-a4bb-b75b2a1b5a3c"
+	valueUUIDv4Upper = "EB6F148A-6637-4C6B-A4BB-B75B2A1B5A3C"
+	valueUUIDv4URN   = "urn:uuid:eb6f148a-6637-4c6b-a4bb-b75b2a1b5a3c"
+)
+
+func TestStatic_Description(t *testing.T) {
+	t.Parallel()
+
+	def := uuiddefault.Static(valueUUIDv4)
+
+	expected := "value defaults to " + valueUUIDv4
+	if got := def.Description(context.Background()); got != expected {
+		t.Errorf("Description()\ngot     : %v\nexpected: %v\n", got, expected)
+	}
+
+	expected = "value defaults to `" + valueUUIDv4 + "`"
+	if got := def.MarkdownDescription(context.Background()); got != expected {
+		t.Errorf("MarkdownDescription()\ngot     : %v\nexpected: %v\n", got, expected)
+	}
+}
+
+func TestStatic_DefaultString(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		uuid     string
+		expected types.String
+	}{
+		{
+			name:     "canonical",
+			uuid:     valueUUIDv4,
+			expected: types.StringValue(valueUUIDv4),
+		},
+		{
+			name:     "uppercase",
+			uuid:     valueUUIDv4Upper,
+			expected: types.StringValue(valueUUIDv4),
+		},
+		{
+			name:     "urn",
+			uuid:     valueUUIDv4URN,
+			expected: types.StringValue(valueUUIDv4),
+		},
+	}
+
+	for _, testcase := range tests {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &defaults.StringResponse{}
+			uuiddefault.Static(testcase.uuid).DefaultString(context.Background(), defaults.StringRequest{Path: path.Root("test")}, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("DefaultString() unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			if diff := cmp.Diff(resp.PlanValue, testcase.expected); diff != "" {
+				t.Errorf("DefaultString()\ngot     : %v\nexpected: %v\ndiff    : %s\n", resp.PlanValue, testcase.expected, diff)
+			}
+		})
+	}
+}
+
+func TestStatic_Panics(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		expected := `uuiddefault: Static("not-a-uuid-at-all"): wrong length: expected 36 characters but got 17`
+		if got := recover(); got != expected {
+			t.Errorf("Static()\npanic   : %v\nexpected: %v\n", got, expected)
+		}
+	}()
+
+	uuiddefault.Static("not-a-uuid-at-all")
+}
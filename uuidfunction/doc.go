@@ -0,0 +1,24 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+// Package uuidfunction provides provider-defined functions for working with
+// UUIDs in Terraform configuration.
+//
+// Register the functions with a provider implementing
+// provider.ProviderWithFunctions:
+//
+//	func (p *exampleProvider) Functions(_ context.Context) []func() function.Function {
+//	    return []func() function.Function{
+//	        uuidfunction.NewParseFunction,
+//	        uuidfunction.NewNormalizeFunction,
+//	    }
+//	}
+//
+// The functions can then be called in configuration, for example,
+// provider::example::uuid_normalize(var.id).
+package uuidfunction
@@ -0,0 +1,176 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidfunction
+
+import (
+	// Standard Library Imports
+	"context"
+	"fmt"
+	"strings"
+
+	// External Imports
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidtypes"
+)
+
+var (
+	_ function.Function = encodeFunction{}
+	_ function.Function = decodeFunction{}
+)
+
+// formatsByName maps the name of each textual UUID format to the format.
+var formatsByName = map[string]uuidtypes.Format{
+	"canonical": uuidtypes.FormatCanonical,
+	"braced":    uuidtypes.FormatBraced,
+	"urn":       uuidtypes.FormatURN,
+	"hex":       uuidtypes.FormatHex,
+	"base64url": uuidtypes.FormatBase64URL,
+	"base32":    uuidtypes.FormatBase32,
+}
+
+// formatParameterDescription describes a parameter accepting a format name.
+const formatParameterDescription = "The name of the format, one of canonical, braced, urn, hex, base64url or base32. Letter case is ignored."
+
+// parseFormatArgument looks up the format named by the string argument at the
+// given position. If the name is not a known format, a function error is
+// returned against the argument.
+func parseFormatArgument(position int64, name string) (uuidtypes.Format, *function.FuncError) {
+	format, ok := formatsByName[strings.ToLower(name)]
+	if !ok {
+		return 0, function.NewArgumentFuncError(
+			position,
+			fmt.Sprintf("Invalid UUID Format: %q is not one of canonical, braced, urn, hex, base64url or base32", name),
+		)
+	}
+
+	return format, nil
+}
+
+// encodeFunction implements the uuid_encode function.
+type encodeFunction struct{}
+
+// Metadata returns the function name.
+func (f encodeFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "uuid_encode"
+}
+
+// Definition returns the function signature.
+func (f encodeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Encode a UUID in a textual format",
+		Description: "Given a UUID and the name of a format, returns the UUID in that format. " +
+			"Hex digits are lowercase, while base32 uses the uppercase RFC 4648 alphabet.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "uuid",
+				Description: uuidParameterDescription,
+			},
+			function.StringParameter{
+				Name:        "format",
+				Description: formatParameterDescription,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run encodes the UUID.
+func (f encodeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value, formatName string
+	resp.Error = req.Arguments.Get(ctx, &value, &formatName)
+	if resp.Error != nil {
+		return
+	}
+
+	uuid, funcErr := parseArgument(0, value)
+	if funcErr != nil {
+		resp.Error = funcErr
+
+		return
+	}
+
+	format, funcErr := parseFormatArgument(1, formatName)
+	if funcErr != nil {
+		resp.Error = funcErr
+
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, uuidtypes.Encode(uuid, format))
+}
+
+// NewEncodeFunction returns the uuid_encode function, which converts a UUID to
+// the named textual format, for example, base64url.
+func NewEncodeFunction() function.Function {
+	return encodeFunction{}
+}
+
+// decodeFunction implements the uuid_decode function.
+type decodeFunction struct{}
+
+// Metadata returns the function name.
+func (f decodeFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "uuid_decode"
+}
+
+// Definition returns the function signature.
+func (f decodeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Decode a UUID from a textual format",
+		Description: "Given a UUID encoded in the named format, returns the UUID in the canonical lowercase hyphenated format.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "value",
+				Description: "The UUID encoded in the given format.",
+			},
+			function.StringParameter{
+				Name:        "format",
+				Description: formatParameterDescription,
+			},
+		},
+		Return: UUIDReturn(),
+	}
+}
+
+// Run decodes the UUID.
+func (f decodeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value, formatName string
+	resp.Error = req.Arguments.Get(ctx, &value, &formatName)
+	if resp.Error != nil {
+		return
+	}
+
+	format, funcErr := parseFormatArgument(1, formatName)
+	if funcErr != nil {
+		resp.Error = funcErr
+
+		return
+	}
+
+	uuid, err := uuidtypes.ParseFormat(value, format)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			0,
+			fmt.Sprintf("Invalid UUID String Value: %q is not a valid %s UUID: %s", value, format, err.Error()),
+		)
+
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, uuidtypes.NewUUIDValue(uuidtypes.Encode(uuid, uuidtypes.FormatCanonical)))
+}
+
+// NewDecodeFunction returns the uuid_decode function, which converts a UUID in
+// the named textual format, for example, base64url, to the canonical
+// lowercase hyphenated format.
+func NewDecodeFunction() function.Function {
+	return decodeFunction{}
+}
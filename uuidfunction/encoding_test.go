@@ -0,0 +1,205 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidfunction_test
+
+import (
+	// Standard Library Imports
+	"context"
+	"testing"
+
+	// External Imports
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidfunction"
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidtypes"
+)
+
+func TestEncodeFunction_Metadata(t *testing.T) {
+	t.Parallel()
+
+	resp := &function.MetadataResponse{}
+	uuidfunction.NewEncodeFunction().Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+	if expected := "uuid_encode"; resp.Name != expected {
+		t.Errorf("Metadata()\ngot     : %v\nexpected: %v\n", resp.Name, expected)
+	}
+}
+
+func TestEncodeFunction_Run(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		request  function.RunRequest
+		expected function.RunResponse
+	}{
+		{
+			name: "base64url",
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(valueUUIDv4), types.StringValue("base64url")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringValue(valueUUIDv4Base64URL)),
+			},
+		},
+		{
+			name: "base32-from-urn",
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(valueUUIDv4URN), types.StringValue("BASE32")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringValue(valueUUIDv4Base32)),
+			},
+		},
+		{
+			name: "hex",
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(valueUUIDv4Upper), types.StringValue("hex")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringValue(valueUUIDv4Hex)),
+			},
+		},
+		{
+			name: "invalid-uuid",
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(valueInvalid), types.StringValue("base64url")}),
+			},
+			expected: function.RunResponse{
+				Error: function.NewArgumentFuncError(
+					0,
+					"Invalid UUID String Value: \"not-a-uuid-at-all\" is not a valid UUID: wrong length: expected 36 characters but got 17",
+				),
+				Result: function.NewResultData(types.StringUnknown()),
+			},
+		},
+		{
+			name: "invalid-format",
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(valueUUIDv4), types.StringValue("base58")}),
+			},
+			expected: function.RunResponse{
+				Error: function.NewArgumentFuncError(
+					1,
+					"Invalid UUID Format: \"base58\" is not one of canonical, braced, urn, hex, base64url or base32",
+				),
+				Result: function.NewResultData(types.StringUnknown()),
+			},
+		},
+	}
+	for _, testcase := range tests {
+		testcase := testcase
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.RunResponse{
+				Result: function.NewResultData(types.StringUnknown()),
+			}
+			uuidfunction.NewEncodeFunction().Run(context.Background(), testcase.request, &resp)
+
+			if diff := cmp.Diff(resp, testcase.expected); diff != "" {
+				t.Errorf("Run()\ngot     : %v\nexpected: %v\ndiff    : %s\n", resp, testcase.expected, diff)
+			}
+		})
+	}
+}
+
+func TestDecodeFunction_Metadata(t *testing.T) {
+	t.Parallel()
+
+	resp := &function.MetadataResponse{}
+	uuidfunction.NewDecodeFunction().Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+	if expected := "uuid_decode"; resp.Name != expected {
+		t.Errorf("Metadata()\ngot     : %v\nexpected: %v\n", resp.Name, expected)
+	}
+}
+
+func TestDecodeFunction_Run(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		request  function.RunRequest
+		expected function.RunResponse
+	}{
+		{
+			name: "base64url",
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(valueUUIDv4Base64URL), types.StringValue("base64url")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(uuidtypes.NewUUIDValue(valueUUIDv4)),
+			},
+		},
+		{
+			name: "base32-lowercase",
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("5nxrjctgg5ggxjf3w5nsug22hq"), types.StringValue("base32")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(uuidtypes.NewUUIDValue(valueUUIDv4)),
+			},
+		},
+		{
+			name: "urn",
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(valueUUIDv4URN), types.StringValue("urn")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(uuidtypes.NewUUIDValue(valueUUIDv4)),
+			},
+		},
+		{
+			name: "wrong-format",
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(valueUUIDv4), types.StringValue("base64url")}),
+			},
+			expected: function.RunResponse{
+				Error: function.NewArgumentFuncError(
+					0,
+					"Invalid UUID String Value: \"eb6f148a-6637-4c6b-a4bb-b75b2a1b5a3c\" is not a valid base64url UUID: unaccepted format: expected base64url",
+				),
+				Result: function.NewResultData(uuidtypes.NewUUIDUnknown()),
+			},
+		},
+		{
+			name: "invalid-format",
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(valueUUIDv4Base64URL), types.StringValue("")}),
+			},
+			expected: function.RunResponse{
+				Error: function.NewArgumentFuncError(
+					1,
+					"Invalid UUID Format: \"\" is not one of canonical, braced, urn, hex, base64url or base32",
+				),
+				Result: function.NewResultData(uuidtypes.NewUUIDUnknown()),
+			},
+		},
+	}
+	for _, testcase := range tests {
+		testcase := testcase
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.RunResponse{
+				Result: function.NewResultData(uuidtypes.NewUUIDUnknown()),
+			}
+			uuidfunction.NewDecodeFunction().Run(context.Background(), testcase.request, &resp)
+
+			if diff := cmp.Diff(resp, testcase.expected); diff != "" {
+				t.Errorf("Run()\ngot     : %v\nexpected: %v\ndiff    : %s\n", resp, testcase.expected, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidfunction
+
+import (
+	// Standard Library Imports
+	"context"
+
+	// External Imports
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidtypes"
+)
+
+var _ function.Function = isValidFunction{}
+
+// isValidFunction implements the uuid_is_valid function.
+type isValidFunction struct{}
+
+// Metadata returns the function name.
+func (f isValidFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "uuid_is_valid"
+}
+
+// Definition returns the function signature.
+func (f isValidFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Check whether a string is a valid UUID",
+		Description: "Given a string, returns true if the string is a UUID in the canonical, braced, URN or " +
+			"32 hex digit format, otherwise false.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "value",
+				Description: "The string to check.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+// Run checks whether the value is a valid UUID.
+func (f isValidFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value string
+	resp.Error = req.Arguments.Get(ctx, &value)
+	if resp.Error != nil {
+		return
+	}
+
+	_, err := uuidtypes.ParseFormat(value, uuidtypes.FormatAll)
+	resp.Error = resp.Result.Set(ctx, err == nil)
+}
+
+// NewIsValidFunction returns the uuid_is_valid function, which reports
+// whether a string is a valid UUID.
+func NewIsValidFunction() function.Function {
+	return isValidFunction{}
+}
@@ -0,0 +1,120 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidfunction_test
+
+import (
+	// Standard Library Imports
+	"context"
+	"testing"
+
+	// External Imports
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidfunction"
+)
+
+func TestIsValidFunction_Metadata(t *testing.T) {
+	t.Parallel()
+
+	resp := &function.MetadataResponse{}
+	uuidfunction.NewIsValidFunction().Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+	if expected := "uuid_is_valid"; resp.Name != expected {
+		t.Errorf("Metadata()\ngot     : %v\nexpected: %v\n", resp.Name, expected)
+	}
+}
+
+func TestIsValidFunction_Definition(t *testing.T) {
+	t.Parallel()
+
+	resp := &function.DefinitionResponse{}
+	uuidfunction.NewIsValidFunction().Definition(context.Background(), function.DefinitionRequest{}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Definition()\ngot unexpected diagnostics: %v\n", resp.Diagnostics)
+	}
+	if len(resp.Definition.Parameters) != 1 {
+		t.Errorf("Definition()\ngot     : %d parameters\nexpected: 1 parameter\n", len(resp.Definition.Parameters))
+	}
+}
+
+func TestIsValidFunction_Run(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		request  function.RunRequest
+		expected function.RunResponse
+	}{
+		{
+			name: "canonical",
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(valueUUIDv4)}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.BoolValue(true)),
+			},
+		},
+		{
+			name: "uppercase",
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(valueUUIDv4Upper)}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.BoolValue(true)),
+			},
+		},
+		{
+			name: "urn",
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(valueUUIDv4URN)}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.BoolValue(true)),
+			},
+		},
+		{
+			name: "invalid",
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(valueInvalid)}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.BoolValue(false)),
+			},
+		},
+		{
+			name: "empty",
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.BoolValue(false)),
+			},
+		},
+	}
+	for _, testcase := range tests {
+		testcase := testcase
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.RunResponse{
+				Result: function.NewResultData(types.BoolUnknown()),
+			}
+			uuidfunction.NewIsValidFunction().Run(context.Background(), testcase.request, &resp)
+
+			if diff := cmp.Diff(resp, testcase.expected); diff != "" {
+				t.Errorf("Run()\ngot     : %v\nexpected: %v\ndiff    : %s\n", resp, testcase.expected, diff)
+			}
+		})
+	}
+}
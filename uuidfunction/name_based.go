@@ -0,0 +1,112 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidfunction
+
+import (
+	// Standard Library Imports
+	"context"
+	"fmt"
+	"strings"
+
+	// External Imports
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidtypes"
+)
+
+var _ function.Function = nameBasedFunction{}
+
+// wellKnownNamespaces maps the names of the namespaces defined by RFC 9562 to
+// their UUIDs.
+var wellKnownNamespaces = map[string][16]byte{
+	"dns":  uuidtypes.NamespaceDNS,
+	"url":  uuidtypes.NamespaceURL,
+	"oid":  uuidtypes.NamespaceOID,
+	"x500": uuidtypes.NamespaceX500,
+}
+
+// nameBasedFunction implements the uuid_v3 and uuid_v5 functions.
+type nameBasedFunction struct {
+	version  uuidtypes.Version
+	hash     string
+	generate func(namespace [16]byte, name string) [16]byte
+}
+
+// Metadata returns the function name.
+func (f nameBasedFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = fmt.Sprintf("uuid_v%d", f.version)
+}
+
+// Definition returns the function signature.
+func (f nameBasedFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: fmt.Sprintf("Generate a name-based %s UUID", f.version),
+		Description: fmt.Sprintf("Given a namespace and a name, returns the %s UUID generated by %s hashing the name within the namespace. ", f.version, f.hash) +
+			"The same namespace and name always generate the same UUID.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name: "namespace",
+				Description: "The namespace of the name, either one of the well-known namespaces, dns, url, oid or x500, " +
+					"or a UUID in the canonical, braced, URN or 32 hex digit format.",
+			},
+			function.StringParameter{
+				Name:        "name",
+				Description: "The name to generate a UUID for.",
+			},
+		},
+		Return: UUIDReturn(),
+	}
+}
+
+// Run generates the name-based UUID.
+func (f nameBasedFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var namespace, name string
+	resp.Error = req.Arguments.Get(ctx, &namespace, &name)
+	if resp.Error != nil {
+		return
+	}
+
+	namespaceUUID, ok := wellKnownNamespaces[strings.ToLower(namespace)]
+	if !ok {
+		var err error
+		namespaceUUID, err = uuidtypes.ParseFormat(namespace, uuidtypes.FormatAll)
+		if err != nil {
+			resp.Error = function.NewArgumentFuncError(
+				0,
+				fmt.Sprintf("Invalid UUID Namespace: %q is neither a well-known namespace (dns, url, oid or x500) nor a valid UUID: %s", namespace, err.Error()),
+			)
+
+			return
+		}
+	}
+
+	uuid := f.generate(namespaceUUID, name)
+	resp.Error = resp.Result.Set(ctx, uuidtypes.NewUUIDValue(uuidtypes.Encode(uuid, uuidtypes.FormatCanonical)))
+}
+
+// NewV3Function returns the uuid_v3 function, which generates a name-based
+// Version 3 UUID using MD5 hashing.
+func NewV3Function() function.Function {
+	return nameBasedFunction{
+		version:  uuidtypes.Version3,
+		hash:     "MD5",
+		generate: uuidtypes.GenerateV3,
+	}
+}
+
+// NewV5Function returns the uuid_v5 function, which generates a name-based
+// Version 5 UUID using SHA-1 hashing.
+func NewV5Function() function.Function {
+	return nameBasedFunction{
+		version:  uuidtypes.Version5,
+		hash:     "SHA-1",
+		generate: uuidtypes.GenerateV5,
+	}
+}
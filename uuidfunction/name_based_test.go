@@ -0,0 +1,225 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidfunction_test
+
+import (
+	// Standard Library Imports
+	"context"
+	"testing"
+
+	// External Imports
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidfunction"
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidtypes"
+)
+
+func TestV3Function_Metadata(t *testing.T) {
+	t.Parallel()
+
+	resp := &function.MetadataResponse{}
+	uuidfunction.NewV3Function().Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+	if expected := "uuid_v3"; resp.Name != expected {
+		t.Errorf("Metadata()\ngot     : %v\nexpected: %v\n", resp.Name, expected)
+	}
+}
+
+func TestV3Function_Definition(t *testing.T) {
+	t.Parallel()
+
+	resp := &function.DefinitionResponse{}
+	uuidfunction.NewV3Function().Definition(context.Background(), function.DefinitionRequest{}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Definition()\ngot unexpected diagnostics: %v\n", resp.Diagnostics)
+	}
+	if len(resp.Definition.Parameters) != 2 {
+		t.Errorf("Definition()\ngot     : %d parameters\nexpected: 2 parameters\n", len(resp.Definition.Parameters))
+	}
+}
+
+func TestV3Function_Run(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		request  function.RunRequest
+		expected function.RunResponse
+	}{
+		{
+			name: "rfc9562-dns",
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("dns"), types.StringValue("www.example.com")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(uuidtypes.NewUUIDValue("5df41881-3aed-3515-88a7-2f4a814cf09e")),
+			},
+		},
+		{
+			name: "url-uppercase",
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("URL"), types.StringValue("https://example.com")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(uuidtypes.NewUUIDValue("68794df6-5e20-385f-ab08-bb73f8a433cb")),
+			},
+		},
+		{
+			name: "custom-braced",
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("{eb6f148a-6637-4c6b-a4bb-b75b2a1b5a3c}"), types.StringValue("name")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(uuidtypes.NewUUIDValue("6585f906-b332-396a-87db-c82b4139389f")),
+			},
+		},
+		{
+			name: "invalid-namespace",
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("example"), types.StringValue("name")}),
+			},
+			expected: function.RunResponse{
+				Error: function.NewArgumentFuncError(
+					0,
+					"Invalid UUID Namespace: \"example\" is neither a well-known namespace (dns, url, oid or x500) nor a valid UUID: wrong length: expected 36 characters but got 7",
+				),
+				Result: function.NewResultData(uuidtypes.NewUUIDUnknown()),
+			},
+		},
+	}
+	for _, testcase := range tests {
+		testcase := testcase
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.RunResponse{
+				Result: function.NewResultData(uuidtypes.NewUUIDUnknown()),
+			}
+			uuidfunction.NewV3Function().Run(context.Background(), testcase.request, &resp)
+
+			if diff := cmp.Diff(resp, testcase.expected); diff != "" {
+				t.Errorf("Run()\ngot     : %v\nexpected: %v\ndiff    : %s\n", resp, testcase.expected, diff)
+			}
+		})
+	}
+}
+
+func TestV5Function_Metadata(t *testing.T) {
+	t.Parallel()
+
+	resp := &function.MetadataResponse{}
+	uuidfunction.NewV5Function().Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+	if expected := "uuid_v5"; resp.Name != expected {
+		t.Errorf("Metadata()\ngot     : %v\nexpected: %v\n", resp.Name, expected)
+	}
+}
+
+func TestV5Function_Definition(t *testing.T) {
+	t.Parallel()
+
+	resp := &function.DefinitionResponse{}
+	uuidfunction.NewV5Function().Definition(context.Background(), function.DefinitionRequest{}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Definition()\ngot unexpected diagnostics: %v\n", resp.Diagnostics)
+	}
+	if len(resp.Definition.Parameters) != 2 {
+		t.Errorf("Definition()\ngot     : %d parameters\nexpected: 2 parameters\n", len(resp.Definition.Parameters))
+	}
+}
+
+func TestV5Function_Run(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		request  function.RunRequest
+		expected function.RunResponse
+	}{
+		{
+			name: "rfc9562-dns",
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("dns"), types.StringValue("www.example.com")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(uuidtypes.NewUUIDValue("2ed6657d-e927-568b-95e1-2665a8aea6a2")),
+			},
+		},
+		{
+			name: "url",
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("url"), types.StringValue("https://example.com")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(uuidtypes.NewUUIDValue("4fd35a71-71ef-5a55-a9d9-aa75c889a6d0")),
+			},
+		},
+		{
+			name: "oid",
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("oid"), types.StringValue("1.3.6.1")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(uuidtypes.NewUUIDValue("1447fa61-5277-5fef-a9b3-fbc6e44f4af3")),
+			},
+		},
+		{
+			name: "x500",
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("x500"), types.StringValue("cn=example")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(uuidtypes.NewUUIDValue("3ecc4f45-80bb-593a-be98-00e146377827")),
+			},
+		},
+		{
+			name: "custom",
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(valueUUIDv4), types.StringValue("name")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(uuidtypes.NewUUIDValue("1aa000ec-cf37-5892-a5d8-2897da781aac")),
+			},
+		},
+		{
+			name: "invalid-namespace",
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("example"), types.StringValue("name")}),
+			},
+			expected: function.RunResponse{
+				Error: function.NewArgumentFuncError(
+					0,
+					"Invalid UUID Namespace: \"example\" is neither a well-known namespace (dns, url, oid or x500) nor a valid UUID: wrong length: expected 36 characters but got 7",
+				),
+				Result: function.NewResultData(uuidtypes.NewUUIDUnknown()),
+			},
+		},
+	}
+	for _, testcase := range tests {
+		testcase := testcase
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.RunResponse{
+				Result: function.NewResultData(uuidtypes.NewUUIDUnknown()),
+			}
+			uuidfunction.NewV5Function().Run(context.Background(), testcase.request, &resp)
+
+			if diff := cmp.Diff(resp, testcase.expected); diff != "" {
+				t.Errorf("Run()\ngot     : %v\nexpected: %v\ndiff    : %s\n", resp, testcase.expected, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidfunction
+
+import (
+	// Standard Library Imports
+	"context"
+
+	// External Imports
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidtypes"
+)
+
+var _ function.Function = normalizeFunction{}
+
+// normalizeFunction implements the uuid_normalize function.
+type normalizeFunction struct{}
+
+// Metadata returns the function name.
+func (f normalizeFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "uuid_normalize"
+}
+
+// Definition returns the function signature.
+func (f normalizeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Normalize a UUID to the canonical format",
+		Description: "Given a UUID, returns the UUID in the canonical lowercase hyphenated format.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "uuid",
+				Description: uuidParameterDescription,
+			},
+		},
+		Return: UUIDReturn(),
+	}
+}
+
+// Run normalizes the UUID.
+func (f normalizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value string
+	resp.Error = req.Arguments.Get(ctx, &value)
+	if resp.Error != nil {
+		return
+	}
+
+	uuid, funcErr := parseArgument(0, value)
+	if funcErr != nil {
+		resp.Error = funcErr
+
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, uuidtypes.NewUUIDValue(uuidtypes.Encode(uuid, uuidtypes.FormatCanonical)))
+}
+
+// NewNormalizeFunction returns the uuid_normalize function, which converts a
+// UUID to the canonical lowercase hyphenated format.
+func NewNormalizeFunction() function.Function {
+	return normalizeFunction{}
+}
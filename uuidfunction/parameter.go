@@ -0,0 +1,41 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidfunction
+
+import (
+	// External Imports
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidtypes"
+)
+
+// UUIDParameter returns a function parameter which accepts a UUID. The
+// parameter uses the uuidtypes.UUIDType custom type, so the argument is
+// validated before the function is run, with any error reported against the
+// argument's position. Read the argument into a uuidtypes.UUIDValue.
+//
+// To apply a type policy, or to only accept a specific version, replace the
+// CustomType of the returned parameter, for example, with
+// uuidtypes.UUIDType{Formats: uuidtypes.FormatAll} or uuidtypes.UUIDv4Type{}.
+func UUIDParameter(name string, description string) function.StringParameter {
+	return function.StringParameter{
+		Name:        name,
+		Description: description,
+		CustomType:  uuidtypes.UUIDType{},
+	}
+}
+
+// UUIDReturn returns a function return which is a UUID. Set the result to a
+// uuidtypes.UUIDValue.
+func UUIDReturn() function.StringReturn {
+	return function.StringReturn{
+		CustomType: uuidtypes.UUIDType{},
+	}
+}
@@ -0,0 +1,193 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidfunction_test
+
+import (
+	// Standard Library Imports
+	"context"
+	"strings"
+	"testing"
+
+	// External Imports
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidfunction"
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidtypes"
+)
+
+// echoFunction returns its UUID argument, so the framework's handling of
+// UUIDParameter and UUIDReturn can be exercised.
+type echoFunction struct{}
+
+func (f echoFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "echo"
+}
+
+func (f echoFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Parameters: []function.Parameter{
+			uuidfunction.UUIDParameter("uuid", "The UUID to echo."),
+		},
+		Return: uuidfunction.UUIDReturn(),
+	}
+}
+
+func (f echoFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value uuidtypes.UUIDValue
+	resp.Error = req.Arguments.Get(ctx, &value)
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, value)
+}
+
+// echoProvider is a provider which only provides the echo function.
+type echoProvider struct{}
+
+func (p echoProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "test"
+}
+
+func (p echoProvider) Schema(_ context.Context, _ provider.SchemaRequest, _ *provider.SchemaResponse) {
+}
+
+func (p echoProvider) Configure(_ context.Context, _ provider.ConfigureRequest, _ *provider.ConfigureResponse) {
+}
+
+func (p echoProvider) DataSources(_ context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+func (p echoProvider) Resources(_ context.Context) []func() resource.Resource {
+	return nil
+}
+
+func (p echoProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		func() function.Function { return echoFunction{} },
+	}
+}
+
+func TestUUIDParameter(t *testing.T) {
+	t.Parallel()
+
+	expected := function.StringParameter{
+		Name:        "uuid",
+		Description: "A UUID.",
+		CustomType:  uuidtypes.UUIDType{},
+	}
+
+	got := uuidfunction.UUIDParameter("uuid", "A UUID.")
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("UUIDParameter()\ngot     : %v\nexpected: %v\ndiff    : %s\n", got, expected, diff)
+	}
+}
+
+func TestUUIDReturn(t *testing.T) {
+	t.Parallel()
+
+	expected := function.StringReturn{
+		CustomType: uuidtypes.UUIDType{},
+	}
+
+	got := uuidfunction.UUIDReturn()
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("UUIDReturn()\ngot     : %v\nexpected: %v\ndiff    : %s\n", got, expected, diff)
+	}
+}
+
+func TestUUIDParameter_CallFunction(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		value            string
+		expectedResult   string
+		expectedArgument *int64
+		expectedError    string
+	}{
+		{
+			name:           "valid",
+			value:          valueUUIDv4,
+			expectedResult: valueUUIDv4,
+		},
+		{
+			name:             "invalid",
+			value:            valueInvalid,
+			expectedArgument: new(int64),
+			expectedError:    "Invalid UUID String Value: ",
+		},
+	}
+	for _, testcase := range tests {
+		testcase := testcase
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			server, err := providerserver.NewProtocol6WithError(echoProvider{})()
+			if err != nil {
+				t.Fatalf("NewProtocol6WithError()\ngot error: %s\n", err)
+			}
+
+			argument, err := tfprotov6.NewDynamicValue(tftypes.String, tftypes.NewValue(tftypes.String, testcase.value))
+			if err != nil {
+				t.Fatalf("NewDynamicValue()\ngot error: %s\n", err)
+			}
+
+			functionServer, ok := server.(tfprotov6.FunctionServer)
+			if !ok {
+				t.Fatalf("provider server does not implement tfprotov6.FunctionServer")
+			}
+
+			resp, err := functionServer.CallFunction(context.Background(), &tfprotov6.CallFunctionRequest{
+				Name:      "echo",
+				Arguments: []*tfprotov6.DynamicValue{&argument},
+			})
+			if err != nil {
+				t.Fatalf("CallFunction()\ngot error: %s\n", err)
+			}
+
+			if testcase.expectedError != "" {
+				if resp.Error == nil || !strings.HasPrefix(resp.Error.Text, testcase.expectedError) {
+					t.Fatalf("CallFunction() error\ngot     : %v\nexpected: %s...\n", resp.Error, testcase.expectedError)
+				}
+				if diff := cmp.Diff(resp.Error.FunctionArgument, testcase.expectedArgument); diff != "" {
+					t.Errorf("CallFunction() error argument\ngot     : %v\nexpected: %v\ndiff    : %s\n", resp.Error.FunctionArgument, testcase.expectedArgument, diff)
+				}
+
+				return
+			}
+
+			if resp.Error != nil {
+				t.Fatalf("CallFunction()\ngot error: %s\n", resp.Error.Text)
+			}
+
+			result, err := resp.Result.Unmarshal(tftypes.String)
+			if err != nil {
+				t.Fatalf("Unmarshal()\ngot error: %s\n", err)
+			}
+
+			var got string
+			if err := result.As(&got); err != nil {
+				t.Fatalf("As()\ngot error: %s\n", err)
+			}
+			if got != testcase.expectedResult {
+				t.Errorf("CallFunction()\ngot     : %v\nexpected: %v\n", got, testcase.expectedResult)
+			}
+		})
+	}
+}
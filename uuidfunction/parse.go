@@ -0,0 +1,107 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidfunction
+
+import (
+	// Standard Library Imports
+	"context"
+	"time"
+
+	// External Imports
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidtypes"
+)
+
+var _ function.Function = parseFunction{}
+
+// parseResultAttributeTypes are the attribute types of the object returned by
+// the uuid_parse function.
+var parseResultAttributeTypes = map[string]attr.Type{
+	"uuid":      types.StringType,
+	"version":   types.Int64Type,
+	"variant":   types.StringType,
+	"bytes":     types.ListType{ElemType: types.Int64Type},
+	"timestamp": types.StringType,
+}
+
+// parseFunction implements the uuid_parse function.
+type parseFunction struct{}
+
+// Metadata returns the function name.
+func (f parseFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "uuid_parse"
+}
+
+// Definition returns the function signature.
+func (f parseFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse a UUID into its components",
+		Description: "Given a UUID, returns an object containing the UUID in the canonical format, its version, " +
+			"its variant, its 16 bytes and, for time-based UUIDs, its timestamp in RFC 3339 format.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "uuid",
+				Description: uuidParameterDescription,
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: parseResultAttributeTypes,
+		},
+	}
+}
+
+// Run parses the UUID.
+func (f parseFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value string
+	resp.Error = req.Arguments.Get(ctx, &value)
+	if resp.Error != nil {
+		return
+	}
+
+	uuid, funcErr := parseArgument(0, value)
+	if funcErr != nil {
+		resp.Error = funcErr
+
+		return
+	}
+
+	uuidBytes := make([]attr.Value, len(uuid))
+	for i, b := range uuid {
+		uuidBytes[i] = types.Int64Value(int64(b))
+	}
+
+	timestamp := types.StringNull()
+	if t, ok := uuidtypes.TimestampOf(uuid); ok {
+		timestamp = types.StringValue(t.Format(time.RFC3339Nano))
+	}
+
+	result, diags := types.ObjectValue(parseResultAttributeTypes, map[string]attr.Value{
+		"uuid":      types.StringValue(uuidtypes.Encode(uuid, uuidtypes.FormatCanonical)),
+		"version":   types.Int64Value(int64(uuidtypes.VersionOf(uuid))),
+		"variant":   types.StringValue(uuidtypes.VariantOf(uuid).String()),
+		"bytes":     types.ListValueMust(types.Int64Type, uuidBytes),
+		"timestamp": timestamp,
+	})
+	resp.Error = function.FuncErrorFromDiags(ctx, diags)
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, result)
+}
+
+// NewParseFunction returns the uuid_parse function, which parses a UUID into
+// an object describing its components.
+func NewParseFunction() function.Function {
+	return parseFunction{}
+}
@@ -0,0 +1,158 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidfunction_test
+
+import (
+	// Standard Library Imports
+	"context"
+	"testing"
+
+	// External Imports
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidfunction"
+)
+
+var parseResultAttributeTypes = map[string]attr.Type{
+	"uuid":      types.StringType,
+	"version":   types.Int64Type,
+	"variant":   types.StringType,
+	"bytes":     types.ListType{ElemType: types.Int64Type},
+	"timestamp": types.StringType,
+}
+
+// parseResult returns the object expected to be returned by uuid_parse.
+func parseResult(uuid string, version int64, variant string, uuidBytes []int64, timestamp types.String) types.Object {
+	byteValues := make([]attr.Value, len(uuidBytes))
+	for i, b := range uuidBytes {
+		byteValues[i] = types.Int64Value(b)
+	}
+
+	return types.ObjectValueMust(parseResultAttributeTypes, map[string]attr.Value{
+		"uuid":      types.StringValue(uuid),
+		"version":   types.Int64Value(version),
+		"variant":   types.StringValue(variant),
+		"bytes":     types.ListValueMust(types.Int64Type, byteValues),
+		"timestamp": timestamp,
+	})
+}
+
+func TestParseFunction_Metadata(t *testing.T) {
+	t.Parallel()
+
+	resp := &function.MetadataResponse{}
+	uuidfunction.NewParseFunction().Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+	if expected := "uuid_parse"; resp.Name != expected {
+		t.Errorf("Metadata()\ngot     : %v\nexpected: %v\n", resp.Name, expected)
+	}
+}
+
+func TestParseFunction_Definition(t *testing.T) {
+	t.Parallel()
+
+	resp := &function.DefinitionResponse{}
+	uuidfunction.NewParseFunction().Definition(context.Background(), function.DefinitionRequest{}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Definition()\ngot unexpected diagnostics: %v\n", resp.Diagnostics)
+	}
+	if len(resp.Definition.Parameters) != 1 {
+		t.Errorf("Definition()\ngot     : %d parameters\nexpected: 1 parameter\n", len(resp.Definition.Parameters))
+	}
+}
+
+func TestParseFunction_Run(t *testing.T) {
+	t.Parallel()
+
+	unknown := types.ObjectUnknown(parseResultAttributeTypes)
+	tests := []struct {
+		name     string
+		request  function.RunRequest
+		expected function.RunResponse
+	}{
+		{
+			name: "v4",
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(valueUUIDv4Upper)}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(parseResult(
+					valueUUIDv4,
+					4,
+					"RFC 9562",
+					[]int64{0xeb, 0x6f, 0x14, 0x8a, 0x66, 0x37, 0x4c, 0x6b, 0xa4, 0xbb, 0xb7, 0x5b, 0x2a, 0x1b, 0x5a, 0x3c},
+					types.StringNull(),
+				)),
+			},
+		},
+		{
+			name: "v1",
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(valueUUIDv1)}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(parseResult(
+					valueUUIDv1,
+					1,
+					"RFC 9562",
+					[]int64{0x4e, 0xa3, 0xc6, 0x66, 0x43, 0x09, 0x11, 0xed, 0xb8, 0x78, 0x02, 0x42, 0xac, 0x12, 0x00, 0x02},
+					types.StringValue("2022-10-03T10:51:17.147607Z"),
+				)),
+			},
+		},
+		{
+			name: "v7",
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(valueUUIDv7)}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(parseResult(
+					valueUUIDv7,
+					7,
+					"RFC 9562",
+					[]int64{0x01, 0x7f, 0x22, 0xe2, 0x79, 0xb0, 0x7c, 0xc3, 0x98, 0xc4, 0xdc, 0x0c, 0x0c, 0x07, 0x39, 0x8f},
+					types.StringValue("2022-02-22T19:22:22Z"),
+				)),
+			},
+		},
+		{
+			name: "invalid",
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(valueInvalid)}),
+			},
+			expected: function.RunResponse{
+				Error: function.NewArgumentFuncError(
+					0,
+					"Invalid UUID String Value: \"not-a-uuid-at-all\" is not a valid UUID: wrong length: expected 36 characters but got 17",
+				),
+				Result: function.NewResultData(unknown),
+			},
+		},
+	}
+	for _, testcase := range tests {
+		testcase := testcase
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.RunResponse{
+				Result: function.NewResultData(unknown),
+			}
+			uuidfunction.NewParseFunction().Run(context.Background(), testcase.request, &resp)
+
+			if diff := cmp.Diff(resp, testcase.expected); diff != "" {
+				t.Errorf("Run()\ngot     : %v\nexpected: %v\ndiff    : %s\n", resp, testcase.expected, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidfunction
+
+import (
+	// Standard Library Imports
+	"context"
+	"fmt"
+	"time"
+
+	// External Imports
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidtypes"
+)
+
+var _ function.Function = timestampFunction{}
+
+// timestampFunction implements the uuid_timestamp function.
+type timestampFunction struct{}
+
+// Metadata returns the function name.
+func (f timestampFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "uuid_timestamp"
+}
+
+// Definition returns the function signature.
+func (f timestampFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Return the timestamp embedded in a time-based UUID",
+		Description: "Given a Version 1, Version 6 or Version 7 UUID, returns the time the UUID was generated as an RFC 3339 string in UTC. " +
+			"Version 1 and Version 6 timestamps have 100-nanosecond precision, while Version 7 timestamps have millisecond precision.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "uuid",
+				Description: uuidParameterDescription,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run returns the timestamp of the UUID.
+func (f timestampFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value string
+	resp.Error = req.Arguments.Get(ctx, &value)
+	if resp.Error != nil {
+		return
+	}
+
+	uuid, funcErr := parseArgument(0, value)
+	if funcErr != nil {
+		resp.Error = funcErr
+
+		return
+	}
+
+	timestamp, ok := uuidtypes.TimestampOf(uuid)
+	if !ok {
+		resp.Error = function.NewArgumentFuncError(
+			0,
+			fmt.Sprintf("Invalid UUID Timestamp: %q is a %s UUID using the %s variant, which does not contain a timestamp. ", value, uuidtypes.VersionOf(uuid), uuidtypes.VariantOf(uuid))+
+				"A Version 1, Version 6 or Version 7 UUID using the RFC 9562 variant is required.",
+		)
+
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, timestamp.Format(time.RFC3339Nano))
+}
+
+// NewTimestampFunction returns the uuid_timestamp function, which returns the
+// time embedded in a time-based UUID as an RFC 3339 string.
+func NewTimestampFunction() function.Function {
+	return timestampFunction{}
+}
@@ -0,0 +1,138 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidfunction_test
+
+import (
+	// Standard Library Imports
+	"context"
+	"testing"
+
+	// External Imports
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidfunction"
+)
+
+func TestTimestampFunction_Metadata(t *testing.T) {
+	t.Parallel()
+
+	resp := &function.MetadataResponse{}
+	uuidfunction.NewTimestampFunction().Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+	if expected := "uuid_timestamp"; resp.Name != expected {
+		t.Errorf("Metadata()\ngot     : %v\nexpected: %v\n", resp.Name, expected)
+	}
+}
+
+func TestTimestampFunction_Definition(t *testing.T) {
+	t.Parallel()
+
+	resp := &function.DefinitionResponse{}
+	uuidfunction.NewTimestampFunction().Definition(context.Background(), function.DefinitionRequest{}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Definition()\ngot unexpected diagnostics: %v\n", resp.Diagnostics)
+	}
+	if len(resp.Definition.Parameters) != 1 {
+		t.Errorf("Definition()\ngot     : %d parameters\nexpected: 1 parameter\n", len(resp.Definition.Parameters))
+	}
+}
+
+func TestTimestampFunction_Run(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		request  function.RunRequest
+		expected function.RunResponse
+	}{
+		{
+			name: "v1",
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(valueUUIDv1)}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringValue("2022-10-03T10:51:17.147607Z")),
+			},
+		},
+		{
+			name: "v1-gregorian-epoch",
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("00000000-0000-1000-8000-000000000000")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringValue("1582-10-15T00:00:00Z")),
+			},
+		},
+		{
+			name: "v6",
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("1EC9414C-232A-6B00-B3C8-9F6BDECED846")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringValue("2022-02-22T19:22:22Z")),
+			},
+		},
+		{
+			name: "v7-millisecond-precision",
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("017f22e2-79b1-7cc3-98c4-dc0c0c07398f")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringValue("2022-02-22T19:22:22.001Z")),
+			},
+		},
+		{
+			name: "v4",
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(valueUUIDv4)}),
+			},
+			expected: function.RunResponse{
+				Error: function.NewArgumentFuncError(
+					0,
+					"Invalid UUID Timestamp: \"eb6f148a-6637-4c6b-a4bb-b75b2a1b5a3c\" is a Version 4 UUID using the RFC 9562 variant, which does not contain a timestamp. "+
+						"A Version 1, Version 6 or Version 7 UUID using the RFC 9562 variant is required.",
+				),
+				Result: function.NewResultData(types.StringUnknown()),
+			},
+		},
+		{
+			name: "invalid",
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(valueInvalid)}),
+			},
+			expected: function.RunResponse{
+				Error: function.NewArgumentFuncError(
+					0,
+					"Invalid UUID String Value: \"not-a-uuid-at-all\" is not a valid UUID: wrong length: expected 36 characters but got 17",
+				),
+				Result: function.NewResultData(types.StringUnknown()),
+			},
+		},
+	}
+	for _, testcase := range tests {
+		testcase := testcase
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.RunResponse{
+				Result: function.NewResultData(types.StringUnknown()),
+			}
+			uuidfunction.NewTimestampFunction().Run(context.Background(), testcase.request, &resp)
+
+			if diff := cmp.Diff(resp, testcase.expected); diff != "" {
+				t.Errorf("Run()\ngot     : %v\nexpected: %v\ndiff    : %s\n", resp, testcase.expected, diff)
+			}
+		})
+	}
+}
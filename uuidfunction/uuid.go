@@ -0,0 +1,38 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidfunction
+
+import (
+	// Standard Library Imports
+	"fmt"
+
+	// External Imports
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidtypes"
+)
+
+// uuidParameterDescription describes a parameter accepting a UUID.
+const uuidParameterDescription = "A UUID in the canonical, braced, URN or 32 hex digit format. Letter case is ignored."
+
+// parseArgument parses the string argument at the given position as a UUID.
+// UUIDs are accepted in any textual format. If the argument is not a valid
+// UUID, a function error is returned against the argument.
+func parseArgument(position int64, value string) ([16]byte, *function.FuncError) {
+	uuid, err := uuidtypes.ParseFormat(value, uuidtypes.FormatAll)
+	if err != nil {
+		return [16]byte{}, function.NewArgumentFuncError(
+			position,
+			fmt.Sprintf("Invalid UUID String Value: %q is not a valid UUID: %s", value, err.Error()),
+		)
+	}
+
+	return uuid, nil
+}
@@ -0,0 +1,22 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidfunction_test
+
+const (
+	valueUUIDv1          = "4ea3c666-4309-11ed-b878-0242ac120002"
+	valueUUIDv4          = "eb6f148a-6637-4c6b-a4bb-b75b2a1b5a3c"
+	valueUUIDv4Upper     = "EB6F148A-6637-4C6B-A4BB-B75B2A1B5A3C"
+	valueUUIDv4URN       = "urn:uuid:eb6f148a-6637-4c6b-a4bb-b75b2a1b5a3c"
+	valueUUIDv4Hex       = "eb6f148a66374c6ba4bbb75b2a1b5a3c"
+	valueUUIDv4Base64URL = "628UimY3TGuku7dbKhtaPA"
+	valueUUIDv4Base32    = "5NXRJCTGG5GGXJF3W5NSUG22HQ"
+	valueUUIDv7          = "017f22e2-79b0-7cc3-98c4-dc0c0c07398f"
+
+	valueInvalid = "not-a-uuid-at-all"
+)
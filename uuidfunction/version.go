@@ -0,0 +1,69 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidfunction
+
+import (
+	// Standard Library Imports
+	"context"
+
+	// External Imports
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidtypes"
+)
+
+var _ function.Function = versionFunction{}
+
+// versionFunction implements the uuid_version function.
+type versionFunction struct{}
+
+// Metadata returns the function name.
+func (f versionFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "uuid_version"
+}
+
+// Definition returns the function signature.
+func (f versionFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Return the version of a UUID",
+		Description: "Given a UUID, returns the version held in its version bits, for example, 4 for a random UUID.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "uuid",
+				Description: uuidParameterDescription,
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+// Run returns the version of the UUID.
+func (f versionFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value string
+	resp.Error = req.Arguments.Get(ctx, &value)
+	if resp.Error != nil {
+		return
+	}
+
+	uuid, funcErr := parseArgument(0, value)
+	if funcErr != nil {
+		resp.Error = funcErr
+
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, int64(uuidtypes.VersionOf(uuid)))
+}
+
+// NewVersionFunction returns the uuid_version function, which returns the
+// version of a UUID.
+func NewVersionFunction() function.Function {
+	return versionFunction{}
+}
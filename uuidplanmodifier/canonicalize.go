@@ -0,0 +1,111 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidplanmodifier
+
+import (
+	// Standard Library Imports
+	"context"
+
+	// External Imports
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidtypes"
+)
+
+var _ planmodifier.String = canonicalizeModifier{}
+
+// canonicalizeModifier implements the plan modifier.
+type canonicalizeModifier struct{}
+
+// Description returns a human-readable description of the plan modifier.
+func (m canonicalizeModifier) Description(_ context.Context) string {
+	return "If the configured UUID is equal to the UUID in state, differing only in letter case or format, the value in state is kept."
+}
+
+// MarkdownDescription returns a markdown description of the plan modifier.
+func (m canonicalizeModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+// PlanModifyString implements the plan modification logic.
+func (m canonicalizeModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Do nothing unless the attribute is known to be Optional and Computed.
+	if req.Plan.Schema == nil {
+		return
+	}
+
+	attribute, diags := req.Plan.Schema.AttributeAtPath(ctx, req.Path)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	if !attribute.IsOptional() || !attribute.IsComputed() {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Plan Modifier Configuration",
+			"An unexpected error occurred while planning a UUID attribute. "+
+				"Canonicalize can only be used with attributes which are both Optional and Computed. "+
+				"Please report this to the provider developers.",
+		)
+
+		return
+	}
+
+	// Do nothing if there is no known planned value or state value to compare.
+	if req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+
+	if req.StateValue.IsNull() || req.StateValue.IsUnknown() {
+		return
+	}
+
+	// Invalid UUIDs are reported by validation, so are left untouched. The
+	// encoded formats are accepted, as a value is only replaced when it
+	// decodes to the same UUID as the value in state.
+	planned, err := uuidtypes.ParseFormat(req.PlanValue.ValueString(), uuidtypes.FormatAll|uuidtypes.FormatEncoded)
+	if err != nil {
+		return
+	}
+
+	prior, err := uuidtypes.ParseFormat(req.StateValue.ValueString(), uuidtypes.FormatAll|uuidtypes.FormatEncoded)
+	if err != nil {
+		return
+	}
+
+	// Do nothing if the UUID has changed.
+	if planned != prior {
+		return
+	}
+
+	resp.PlanValue = req.StateValue
+}
+
+// Canonicalize returns a plan modifier which suppresses plan differences
+// between a configured UUID and the UUID in state when they only differ in
+// letter case or format, for example, EB6F148A-6637-4C6B-A4BB-B75B2A1B5A3C and
+// eb6f148a-6637-4c6b-a4bb-b75b2a1b5a3c. UUIDs are parsed in any format
+// accepted by uuidtypes.ParseFormat, including the encoded formats. Null and
+// unknown values are left untouched.
+//
+// When the configured UUID is equal to the UUID in state, the prior state
+// value is planned as is, in whichever format it was stored, so the planned
+// value is only canonical if the provider stored a canonical value. Planning a
+// value other than the configured value is only valid for Computed
+// attributes, so this plan modifier must only be attached to attributes which
+// are both Optional and Computed, and otherwise raises an error diagnostic.
+// On apply, the resource must then keep the planned value in state.
+//
+// This plan modifier is intended for providers which can not yet adopt
+// uuidtypes.UUIDType, which provides semantic equality.
+func Canonicalize() planmodifier.String {
+	return canonicalizeModifier{}
+}
@@ -0,0 +1,226 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidplanmodifier_test
+
+import (
+	// Standard Library Imports
+	"context"
+	"testing"
+
+	// External Imports
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidplanmodifier"
+)
+
+const (
+	valueUUIDv4       = "eb6f148a-6637-4c6b-a4bb-b75b2a1b5a3c"
+	valueUUIDv4Upper  = "EB6F148A-6637-4C6B-A4BB-B75B2A1B5A3C"
+	valueUUIDv4Braced = "{eb6f148a-6637-4c6b-a4bb-b75b2a1b5a3c}"
+	valueUUIDv7       = "017f22e2-79b0-7cc3-98c4-dc0c0c07398f"
+
+	valueUUIDv4Base64URL = "628UimY3TGuku7dbKhtaPA"
+)
+
+// canonicalizePlan returns a plan with the given attribute at the path id.
+func canonicalizePlan(attribute schema.StringAttribute) tfsdk.Plan {
+	return tfsdk.Plan{
+		Schema: schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				"id": attribute,
+			},
+		},
+	}
+}
+
+func TestCanonicalize_Description(t *testing.T) {
+	t.Parallel()
+
+	modifier := uuidplanmodifier.Canonicalize()
+	expected := "If the configured UUID is equal to the UUID in state, differing only in letter case or format, the value in state is kept."
+	if got := modifier.Description(context.Background()); got != expected {
+		t.Errorf("Description()\ngot     : %v\nexpected: %v\n", got, expected)
+	}
+	if got := modifier.MarkdownDescription(context.Background()); got != expected {
+		t.Errorf("MarkdownDescription()\ngot     : %v\nexpected: %v\n", got, expected)
+	}
+}
+
+func TestCanonicalize_PlanModifyString(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		plan     types.String
+		state    types.String
+		expected types.String
+	}{
+		{
+			name:     "plan-null",
+			plan:     types.StringNull(),
+			state:    types.StringValue(valueUUIDv4),
+			expected: types.StringNull(),
+		},
+		{
+			name:     "plan-unknown",
+			plan:     types.StringUnknown(),
+			state:    types.StringValue(valueUUIDv4),
+			expected: types.StringUnknown(),
+		},
+		{
+			name:     "state-null",
+			plan:     types.StringValue(valueUUIDv4Upper),
+			state:    types.StringNull(),
+			expected: types.StringValue(valueUUIDv4Upper),
+		},
+		{
+			name:     "uppercase",
+			plan:     types.StringValue(valueUUIDv4Upper),
+			state:    types.StringValue(valueUUIDv4),
+			expected: types.StringValue(valueUUIDv4),
+		},
+		{
+			name:     "braced",
+			plan:     types.StringValue(valueUUIDv4Braced),
+			state:    types.StringValue(valueUUIDv4),
+			expected: types.StringValue(valueUUIDv4),
+		},
+		{
+			name:     "base64url",
+			plan:     types.StringValue(valueUUIDv4Base64URL),
+			state:    types.StringValue(valueUUIDv4),
+			expected: types.StringValue(valueUUIDv4),
+		},
+		{
+			name:     "already-canonical",
+			plan:     types.StringValue(valueUUIDv4),
+			state:    types.StringValue(valueUUIDv4Upper),
+			expected: types.StringValue(valueUUIDv4Upper),
+		},
+		{
+			name:     "changed",
+			plan:     types.StringValue(valueUUIDv4Upper),
+			state:    types.StringValue(valueUUIDv7),
+			expected: types.StringValue(valueUUIDv4Upper),
+		},
+		{
+			name:     "plan-invalid",
+			plan:     types.StringValue("not-a-uuid"),
+			state:    types.StringValue(valueUUIDv4),
+			expected: types.StringValue("not-a-uuid"),
+		},
+		{
+			name:     "state-invalid",
+			plan:     types.StringValue(valueUUIDv4Upper),
+			state:    types.StringValue("not-a-uuid"),
+			expected: types.StringValue(valueUUIDv4Upper),
+		},
+	}
+	for _, testcase := range tests {
+		testcase := testcase
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := planmodifier.StringRequest{
+				Path:        path.Root("id"),
+				Plan:        canonicalizePlan(schema.StringAttribute{Optional: true, Computed: true}),
+				ConfigValue: testcase.plan,
+				PlanValue:   testcase.plan,
+				StateValue:  testcase.state,
+			}
+			resp := &planmodifier.StringResponse{
+				PlanValue: req.PlanValue,
+			}
+			uuidplanmodifier.Canonicalize().PlanModifyString(context.Background(), req, resp)
+
+			if diff := cmp.Diff(resp.PlanValue, testcase.expected); diff != "" {
+				t.Errorf("PlanModifyString()\ngot     : %v\nexpected: %v\ndiff    : %s\n", resp.PlanValue, testcase.expected, diff)
+			}
+			if resp.Diagnostics.HasError() {
+				t.Errorf("PlanModifyString()\ngot unexpected diagnostics: %v\n", resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestCanonicalize_PlanModifyString_Attribute(t *testing.T) {
+	t.Parallel()
+
+	invalidConfiguration := diag.Diagnostics{
+		diag.NewAttributeErrorDiagnostic(
+			path.Root("id"),
+			"Invalid Plan Modifier Configuration",
+			"An unexpected error occurred while planning a UUID attribute. "+
+				"Canonicalize can only be used with attributes which are both Optional and Computed. "+
+				"Please report this to the provider developers.",
+		),
+	}
+
+	tests := []struct {
+		name          string
+		plan          tfsdk.Plan
+		expected      types.String
+		expectedDiags diag.Diagnostics
+	}{
+		{
+			name:     "optional-computed",
+			plan:     canonicalizePlan(schema.StringAttribute{Optional: true, Computed: true}),
+			expected: types.StringValue(valueUUIDv4),
+		},
+		{
+			name:          "required",
+			plan:          canonicalizePlan(schema.StringAttribute{Required: true}),
+			expected:      types.StringValue(valueUUIDv4Upper),
+			expectedDiags: invalidConfiguration,
+		},
+		{
+			name:          "optional",
+			plan:          canonicalizePlan(schema.StringAttribute{Optional: true}),
+			expected:      types.StringValue(valueUUIDv4Upper),
+			expectedDiags: invalidConfiguration,
+		},
+		{
+			name:     "no-schema",
+			plan:     tfsdk.Plan{},
+			expected: types.StringValue(valueUUIDv4Upper),
+		},
+	}
+	for _, testcase := range tests {
+		testcase := testcase
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := planmodifier.StringRequest{
+				Path:        path.Root("id"),
+				Plan:        testcase.plan,
+				ConfigValue: types.StringValue(valueUUIDv4Upper),
+				PlanValue:   types.StringValue(valueUUIDv4Upper),
+				StateValue:  types.StringValue(valueUUIDv4),
+			}
+			resp := &planmodifier.StringResponse{
+				PlanValue: req.PlanValue,
+			}
+			uuidplanmodifier.Canonicalize().PlanModifyString(context.Background(), req, resp)
+
+			if diff := cmp.Diff(resp.PlanValue, testcase.expected); diff != "" {
+				t.Errorf("PlanModifyString()\ngot     : %v\nexpected: %v\ndiff    : %s\n", resp.PlanValue, testcase.expected, diff)
+			}
+			if diff := cmp.Diff(resp.Diagnostics, testcase.expectedDiags); diff != "" {
+				t.Errorf("PlanModifyString()\ngot     : %v\nexpected: %v\ndiff    : %s\n", resp.Diagnostics, testcase.expectedDiags, diff)
+			}
+		})
+	}
+}
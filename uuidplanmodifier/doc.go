@@ -0,0 +1,14 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+// Package uuidplanmodifier provides plan modifiers for UUID attributes.
+//
+// The plan modifiers implement planmodifier.String, so can be attached to
+// either a plain schema.StringAttribute or one using the uuidtypes.UUIDType
+// custom type.
+package uuidplanmodifier
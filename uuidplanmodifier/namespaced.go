@@ -0,0 +1,156 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidplanmodifier
+
+import (
+	// Standard Library Imports
+	"context"
+	"fmt"
+	"strings"
+
+	// External Imports
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidtypes"
+)
+
+var _ planmodifier.String = namespacedModifier{}
+
+// namespacedModifier implements the plan modifier.
+type namespacedModifier struct {
+	namespace   [16]byte
+	expressions path.Expressions
+}
+
+// Description returns a human-readable description of the plan modifier.
+func (m namespacedModifier) Description(_ context.Context) string {
+	return fmt.Sprintf("If not configured, defaults to the Version 5 UUID of %s in the namespace %s.", m.expressions, uuidtypes.Encode(m.namespace, uuidtypes.FormatCanonical))
+}
+
+// MarkdownDescription returns a markdown description of the plan modifier.
+func (m namespacedModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+// PlanModifyString implements the plan modification logic.
+func (m namespacedModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Do nothing if there is a known planned value, such as when the value is
+	// configured, unchanged or the resource is being destroyed.
+	if !req.PlanValue.IsUnknown() {
+		return
+	}
+
+	// Do nothing if the value is configured, even if not yet known.
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+
+	var names []string
+	for _, expression := range m.expressions {
+		matchedPaths, diags := req.Plan.PathMatches(ctx, req.PathExpression.Merge(expression))
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			continue
+		}
+
+		for _, matchedPath := range matchedPaths {
+			var value attr.Value
+			diags := req.Plan.GetAttribute(ctx, matchedPath, &value)
+			resp.Diagnostics.Append(diags...)
+			if diags.HasError() {
+				continue
+			}
+
+			// Leave the value unknown until every name is known.
+			if value.IsUnknown() {
+				return
+			}
+
+			// The practitioner must either configure the referenced
+			// attribute, or the UUID attribute itself.
+			if value.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					matchedPath,
+					"Missing Attribute Configuration",
+					fmt.Sprintf("Attribute %q must be specified when %q is not specified, as %q defaults to a UUID derived from its value.", matchedPath, req.Path, req.Path),
+				)
+
+				continue
+			}
+
+			stringValuable, ok := value.(basetypes.StringValuable)
+			if !ok {
+				resp.Diagnostics.AddAttributeError(
+					req.Path,
+					"Invalid Namespaced UUID Name",
+					fmt.Sprintf("The UUID is derived from the value of %s, which must be a string. ", matchedPath)+
+						"Please report this to the provider developers.\n\n"+
+						fmt.Sprintf("Value: %s", value),
+				)
+
+				continue
+			}
+
+			stringValue, diags := stringValuable.ToStringValue(ctx)
+			resp.Diagnostics.Append(diags...)
+			names = append(names, stringValue.ValueString())
+		}
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Without a name, every resource would be planned the same UUID.
+	if len(names) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Plan Modifier Configuration",
+			"An unexpected error occurred while planning a UUID attribute. "+
+				"Namespaced derives the UUID from the values of other attributes, but no attributes were matched. "+
+				"Please report this to the provider developers.\n\n"+
+				fmt.Sprintf("Path Expressions: %s", m.expressions),
+		)
+
+		return
+	}
+
+	uuid := uuidtypes.GenerateV5(m.namespace, strings.Join(names, "/"))
+	resp.PlanValue = types.StringValue(uuidtypes.Encode(uuid, uuidtypes.FormatCanonical))
+}
+
+// Namespaced returns a plan modifier for Optional and Computed UUID attributes
+// which, if not configured, defaults the attribute to the deterministic
+// Version 5 UUID of the values of other string attributes, identified by the
+// given path expressions, in the given namespace. Relative expressions are
+// resolved from the attribute being planned. Where more than one value is
+// matched, the name is formed by joining the values, in order, with "/".
+//
+// As the UUID is derived from the plan, it is recomputed whenever the
+// resource changes, and only changes when a referenced value changes. While
+// a referenced value is unknown, the UUID is planned as "(known after apply)"
+// and must be computed by the resource with uuidtypes.GenerateV5. Referenced
+// attributes must be strings, and at least one attribute must be matched. If
+// a referenced attribute is null, an error
+// diagnostic asks the practitioner to configure either it or the UUID
+// attribute.
+//
+// This is a plan modifier rather than a schema default, as schema defaults
+// can not read the value of other attributes.
+func Namespaced(namespace [16]byte, expressions ...path.Expression) planmodifier.String {
+	return namespacedModifier{
+		namespace:   namespace,
+		expressions: expressions,
+	}
+}
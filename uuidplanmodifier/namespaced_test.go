@@ -0,0 +1,306 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidplanmodifier_test
+
+import (
+	// Standard Library Imports
+	"context"
+	"testing"
+
+	// External Imports
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidplanmodifier"
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidtypes"
+)
+
+// valueUUIDv5ExampleDNS is the Version 5 UUID of "www.example.com" in the DNS
+// namespace.
+const valueUUIDv5ExampleDNS = "2ed6657d-e927-568b-95e1-2665a8aea6a2"
+
+// namespacedPlan returns a plan for a resource with the given name and region.
+func namespacedPlan(name tftypes.Value, region tftypes.Value) tfsdk.Plan {
+	objectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":     tftypes.String,
+			"name":   tftypes.String,
+			"region": tftypes.String,
+			"count":  tftypes.Number,
+		},
+	}
+
+	return tfsdk.Plan{
+		Schema: schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					CustomType: uuidtypes.UUIDType{},
+					Optional:   true,
+					Computed:   true,
+				},
+				"name": schema.StringAttribute{
+					Required: true,
+				},
+				"region": schema.StringAttribute{
+					Optional: true,
+				},
+				"count": schema.NumberAttribute{
+					Optional: true,
+				},
+			},
+		},
+		Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"id":     tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			"name":   name,
+			"region": region,
+			"count":  tftypes.NewValue(tftypes.Number, 1),
+		}),
+	}
+}
+
+func TestNamespaced_Description(t *testing.T) {
+	t.Parallel()
+
+	modifier := uuidplanmodifier.Namespaced(uuidtypes.NamespaceDNS, path.MatchRoot("name"))
+	expected := "If not configured, defaults to the Version 5 UUID of [name] in the namespace 6ba7b810-9dad-11d1-80b4-00c04fd430c8."
+	if got := modifier.Description(context.Background()); got != expected {
+		t.Errorf("Description()\ngot     : %v\nexpected: %v\n", got, expected)
+	}
+	if got := modifier.MarkdownDescription(context.Background()); got != expected {
+		t.Errorf("MarkdownDescription()\ngot     : %v\nexpected: %v\n", got, expected)
+	}
+}
+
+func TestNamespaced_PlanModifyString(t *testing.T) {
+	t.Parallel()
+
+	name := tftypes.NewValue(tftypes.String, "www.example.com")
+	region := tftypes.NewValue(tftypes.String, "us-east-1")
+
+	tests := []struct {
+		name          string
+		expressions   path.Expressions
+		request       planmodifier.StringRequest
+		expected      types.String
+		expectedDiags diag.Diagnostics
+	}{
+		{
+			name:        "not-configured",
+			expressions: path.Expressions{path.MatchRoot("name")},
+			request: planmodifier.StringRequest{
+				Path:           path.Root("id"),
+				PathExpression: path.MatchRoot("id"),
+				Plan:           namespacedPlan(name, region),
+				ConfigValue:    types.StringNull(),
+				PlanValue:      types.StringUnknown(),
+				StateValue:     types.StringNull(),
+			},
+			expected: types.StringValue(valueUUIDv5ExampleDNS),
+		},
+		{
+			name:        "not-configured-relative-expressions",
+			expressions: path.Expressions{path.MatchRelative().AtParent().AtName("name"), path.MatchRoot("region")},
+			request: planmodifier.StringRequest{
+				Path:           path.Root("id"),
+				PathExpression: path.MatchRoot("id"),
+				Plan:           namespacedPlan(name, region),
+				ConfigValue:    types.StringNull(),
+				PlanValue:      types.StringUnknown(),
+				StateValue:     types.StringNull(),
+			},
+			expected: types.StringValue(uuidtypes.Encode(uuidtypes.GenerateV5(uuidtypes.NamespaceDNS, "www.example.com/us-east-1"), uuidtypes.FormatCanonical)),
+		},
+		{
+			name:        "not-configured-unknown-name",
+			expressions: path.Expressions{path.MatchRoot("name")},
+			request: planmodifier.StringRequest{
+				Path:           path.Root("id"),
+				PathExpression: path.MatchRoot("id"),
+				Plan:           namespacedPlan(tftypes.NewValue(tftypes.String, tftypes.UnknownValue), region),
+				ConfigValue:    types.StringNull(),
+				PlanValue:      types.StringUnknown(),
+				StateValue:     types.StringNull(),
+			},
+			expected: types.StringUnknown(),
+		},
+		{
+			name:        "not-configured-null-name",
+			expressions: path.Expressions{path.MatchRoot("region")},
+			request: planmodifier.StringRequest{
+				Path:           path.Root("id"),
+				PathExpression: path.MatchRoot("id"),
+				Plan:           namespacedPlan(name, tftypes.NewValue(tftypes.String, nil)),
+				ConfigValue:    types.StringNull(),
+				PlanValue:      types.StringUnknown(),
+				StateValue:     types.StringNull(),
+			},
+			expected: types.StringUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("region"),
+					"Missing Attribute Configuration",
+					`Attribute "region" must be specified when "id" is not specified, as "id" defaults to a UUID derived from its value.`,
+				),
+			},
+		},
+		{
+			name:        "not-configured-null-name-of-many",
+			expressions: path.Expressions{path.MatchRoot("name"), path.MatchRoot("region")},
+			request: planmodifier.StringRequest{
+				Path:           path.Root("id"),
+				PathExpression: path.MatchRoot("id"),
+				Plan:           namespacedPlan(name, tftypes.NewValue(tftypes.String, nil)),
+				ConfigValue:    types.StringNull(),
+				PlanValue:      types.StringUnknown(),
+				StateValue:     types.StringNull(),
+			},
+			expected: types.StringUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("region"),
+					"Missing Attribute Configuration",
+					`Attribute "region" must be specified when "id" is not specified, as "id" defaults to a UUID derived from its value.`,
+				),
+			},
+		},
+		{
+			name:        "configured-null-name",
+			expressions: path.Expressions{path.MatchRoot("region")},
+			request: planmodifier.StringRequest{
+				Path:           path.Root("id"),
+				PathExpression: path.MatchRoot("id"),
+				Plan:           namespacedPlan(name, tftypes.NewValue(tftypes.String, nil)),
+				ConfigValue:    types.StringValue(valueUUIDv4),
+				PlanValue:      types.StringValue(valueUUIDv4),
+				StateValue:     types.StringNull(),
+			},
+			expected: types.StringValue(valueUUIDv4),
+		},
+		{
+			name: "not-configured-no-expressions",
+			request: planmodifier.StringRequest{
+				Path:           path.Root("id"),
+				PathExpression: path.MatchRoot("id"),
+				Plan:           namespacedPlan(name, region),
+				ConfigValue:    types.StringNull(),
+				PlanValue:      types.StringUnknown(),
+				StateValue:     types.StringNull(),
+			},
+			expected: types.StringUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("id"),
+					"Invalid Plan Modifier Configuration",
+					"An unexpected error occurred while planning a UUID attribute. "+
+						"Namespaced derives the UUID from the values of other attributes, but no attributes were matched. "+
+						"Please report this to the provider developers.\n\n"+
+						"Path Expressions: []",
+				),
+			},
+		},
+		{
+			name:        "not-configured-non-string-name",
+			expressions: path.Expressions{path.MatchRoot("count")},
+			request: planmodifier.StringRequest{
+				Path:           path.Root("id"),
+				PathExpression: path.MatchRoot("id"),
+				Plan:           namespacedPlan(name, region),
+				ConfigValue:    types.StringNull(),
+				PlanValue:      types.StringUnknown(),
+				StateValue:     types.StringNull(),
+			},
+			expected: types.StringUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("id"),
+					"Invalid Namespaced UUID Name",
+					"The UUID is derived from the value of count, which must be a string. "+
+						"Please report this to the provider developers.\n\n"+
+						"Value: 1",
+				),
+			},
+		},
+		{
+			name:        "configured",
+			expressions: path.Expressions{path.MatchRoot("name")},
+			request: planmodifier.StringRequest{
+				Path:           path.Root("id"),
+				PathExpression: path.MatchRoot("id"),
+				Plan:           namespacedPlan(name, region),
+				ConfigValue:    types.StringValue(valueUUIDv4),
+				PlanValue:      types.StringValue(valueUUIDv4),
+				StateValue:     types.StringNull(),
+			},
+			expected: types.StringValue(valueUUIDv4),
+		},
+		{
+			name:        "configured-unknown",
+			expressions: path.Expressions{path.MatchRoot("name")},
+			request: planmodifier.StringRequest{
+				Path:           path.Root("id"),
+				PathExpression: path.MatchRoot("id"),
+				Plan:           namespacedPlan(name, region),
+				ConfigValue:    types.StringUnknown(),
+				PlanValue:      types.StringUnknown(),
+				StateValue:     types.StringNull(),
+			},
+			expected: types.StringUnknown(),
+		},
+		{
+			name:        "unchanged",
+			expressions: path.Expressions{path.MatchRoot("name")},
+			request: planmodifier.StringRequest{
+				Path:           path.Root("id"),
+				PathExpression: path.MatchRoot("id"),
+				Plan:           namespacedPlan(name, region),
+				ConfigValue:    types.StringNull(),
+				PlanValue:      types.StringValue(valueUUIDv5ExampleDNS),
+				StateValue:     types.StringValue(valueUUIDv5ExampleDNS),
+			},
+			expected: types.StringValue(valueUUIDv5ExampleDNS),
+		},
+		{
+			name:        "destroy",
+			expressions: path.Expressions{path.MatchRoot("name")},
+			request: planmodifier.StringRequest{
+				Path:           path.Root("id"),
+				PathExpression: path.MatchRoot("id"),
+				ConfigValue:    types.StringNull(),
+				PlanValue:      types.StringNull(),
+				StateValue:     types.StringValue(valueUUIDv5ExampleDNS),
+			},
+			expected: types.StringNull(),
+		},
+	}
+	for _, testcase := range tests {
+		testcase := testcase
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.StringResponse{
+				PlanValue: testcase.request.PlanValue,
+			}
+			uuidplanmodifier.Namespaced(uuidtypes.NamespaceDNS, testcase.expressions...).PlanModifyString(context.Background(), testcase.request, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testcase.expectedDiags); diff != "" {
+				t.Errorf("PlanModifyString() diagnostics\ngot     : %v\nexpected: %v\ndiff    : %s\n", resp.Diagnostics, testcase.expectedDiags, diff)
+			}
+			if diff := cmp.Diff(resp.PlanValue, testcase.expected); diff != "" {
+				t.Errorf("PlanModifyString()\ngot     : %v\nexpected: %v\ndiff    : %s\n", resp.PlanValue, testcase.expected, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,379 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidtypes
+
+import (
+	// Standard Library Imports
+	"bytes"
+	"context"
+	"fmt"
+	"slices"
+
+	// External Imports
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// ListValueToUUIDs returns the elements of a list of UUIDs, such as a
+// types.List with an ElementType of UUIDType{} or types.StringType. Each
+// element is validated as UUIDValue.ValueUUID does, with diagnostics
+// identifying the index of the invalid element. If the list is null or
+// unknown, nil is returned.
+func ListValueToUUIDs(ctx context.Context, list basetypes.ListValuable) ([]UUIDValue, diag.Diagnostics) {
+	listValue, diags := list.ToListValue(ctx)
+	if diags.HasError() || listValue.IsNull() || listValue.IsUnknown() {
+		return nil, diags
+	}
+
+	return elementsToUUIDs(ctx, listValue.Elements(), func(i int, _ UUIDValue) string {
+		return fmt.Sprintf("list element at index %d", i)
+	})
+}
+
+// SetValueToUUIDs returns the elements of a set of UUIDs, such as a types.Set
+// with an ElementType of UUIDType{} or types.StringType. Each element is
+// validated as UUIDValue.ValueUUID does, with diagnostics identifying the
+// invalid element. If the set is null or unknown, nil is returned.
+func SetValueToUUIDs(ctx context.Context, set basetypes.SetValuable) ([]UUIDValue, diag.Diagnostics) {
+	setValue, diags := set.ToSetValue(ctx)
+	if diags.HasError() || setValue.IsNull() || setValue.IsUnknown() {
+		return nil, diags
+	}
+
+	return elementsToUUIDs(ctx, setValue.Elements(), func(_ int, value UUIDValue) string {
+		return fmt.Sprintf("set element %s", value)
+	})
+}
+
+// MapValueToUUIDs returns the elements of a map of UUIDs, such as a types.Map
+// with an ElementType of UUIDType{} or types.StringType. Each element is
+// validated as UUIDValue.ValueUUID does, with diagnostics identifying the key
+// of the invalid element. If the map is null or unknown, nil is returned.
+func MapValueToUUIDs(ctx context.Context, m basetypes.MapValuable) (map[string]UUIDValue, diag.Diagnostics) {
+	mapValue, diags := m.ToMapValue(ctx)
+	if diags.HasError() || mapValue.IsNull() || mapValue.IsUnknown() {
+		return nil, diags
+	}
+
+	elements := mapValue.Elements()
+	keys := make([]string, 0, len(elements))
+	for key := range elements {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	values := make([]attr.Value, len(keys))
+	for i, key := range keys {
+		values[i] = elements[key]
+	}
+
+	uuids, elementDiags := elementsToUUIDs(ctx, values, func(i int, _ UUIDValue) string {
+		return fmt.Sprintf("map element with key %q", keys[i])
+	})
+	diags.Append(elementDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	out := make(map[string]UUIDValue, len(keys))
+	for i, key := range keys {
+		out[key] = uuids[i]
+	}
+
+	return out, diags
+}
+
+// UUIDsToListValue returns a list with an ElementType of UUIDType{} containing
+// the given UUIDs.
+func UUIDsToListValue(_ context.Context, uuids []UUIDValue) (basetypes.ListValue, diag.Diagnostics) {
+	return basetypes.NewListValue(UUIDType{}, uuidsToElements(uuids))
+}
+
+// UUIDsToSetValue returns a set with an ElementType of UUIDType{} containing
+// the given UUIDs.
+func UUIDsToSetValue(_ context.Context, uuids []UUIDValue) (basetypes.SetValue, diag.Diagnostics) {
+	return basetypes.NewSetValue(UUIDType{}, uuidsToElements(uuids))
+}
+
+// UUIDsToMapValue returns a map with an ElementType of UUIDType{} containing
+// the given UUIDs.
+func UUIDsToMapValue(_ context.Context, uuids map[string]UUIDValue) (basetypes.MapValue, diag.Diagnostics) {
+	elements := make(map[string]attr.Value, len(uuids))
+	for key, value := range uuids {
+		elements[key] = UUIDValue{StringValue: value.StringValue}
+	}
+
+	return basetypes.NewMapValue(UUIDType{}, elements)
+}
+
+// ListValueToBytes returns the 16 byte representation of each element of a
+// list of UUIDs. Null and unknown elements can't be represented, so are
+// reported as invalid. If the list is null or unknown, nil is returned.
+func ListValueToBytes(ctx context.Context, list basetypes.ListValuable) ([][16]byte, diag.Diagnostics) {
+	uuids, diags := ListValueToUUIDs(ctx, list)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return uuidsToBytes(uuids, func(i int, _ UUIDValue) string {
+		return fmt.Sprintf("list element at index %d", i)
+	})
+}
+
+// SetValueToBytes returns the 16 byte representation of each element of a set
+// of UUIDs. Null and unknown elements can't be represented, so are reported as
+// invalid. If the set is null or unknown, nil is returned.
+func SetValueToBytes(ctx context.Context, set basetypes.SetValuable) ([][16]byte, diag.Diagnostics) {
+	uuids, diags := SetValueToUUIDs(ctx, set)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return uuidsToBytes(uuids, func(_ int, value UUIDValue) string {
+		return fmt.Sprintf("set element %s", value)
+	})
+}
+
+// MapValueToBytes returns the 16 byte representation of each element of a map
+// of UUIDs. Null and unknown elements can't be represented, so are reported as
+// invalid. If the map is null or unknown, nil is returned.
+func MapValueToBytes(ctx context.Context, m basetypes.MapValuable) (map[string][16]byte, diag.Diagnostics) {
+	uuids, diags := MapValueToUUIDs(ctx, m)
+	if diags.HasError() || uuids == nil {
+		return nil, diags
+	}
+
+	out := make(map[string][16]byte, len(uuids))
+	for key, value := range uuids {
+		if value.IsNull() || value.IsUnknown() {
+			diags.Append(missingElementError(fmt.Sprintf("map element with key %q", key), value))
+
+			continue
+		}
+
+		out[key], _ = value.ValueUUID()
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return out, diags
+}
+
+// BytesToListValue returns a list with an ElementType of UUIDType{} containing
+// the given UUIDs in the canonical format.
+func BytesToListValue(ctx context.Context, uuids [][16]byte) (basetypes.ListValue, diag.Diagnostics) {
+	return UUIDsToListValue(ctx, bytesToUUIDs(uuids))
+}
+
+// BytesToSetValue returns a set with an ElementType of UUIDType{} containing
+// the given UUIDs in the canonical format.
+func BytesToSetValue(ctx context.Context, uuids [][16]byte) (basetypes.SetValue, diag.Diagnostics) {
+	return UUIDsToSetValue(ctx, bytesToUUIDs(uuids))
+}
+
+// BytesToMapValue returns a map with an ElementType of UUIDType{} containing
+// the given UUIDs in the canonical format.
+func BytesToMapValue(ctx context.Context, uuids map[string][16]byte) (basetypes.MapValue, diag.Diagnostics) {
+	values := make(map[string]UUIDValue, len(uuids))
+	for key, uuid := range uuids {
+		values[key] = NewUUIDValueFromBytes(uuid)
+	}
+
+	return UUIDsToMapValue(ctx, values)
+}
+
+// elementsToUUIDs converts collection elements to UUIDValues, validating each.
+// Elements created by a UUIDType, or a version-constrained type such as
+// UUIDv4Type, keep the type's policy, while elements of any other string type
+// are validated as a UUIDType{} would. The describe function
+// returns a human-friendly description of the element, for diagnostics.
+func elementsToUUIDs(ctx context.Context, elements []attr.Value, describe func(int, UUIDValue) string) ([]UUIDValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	out := make([]UUIDValue, len(elements))
+	for i, element := range elements {
+		switch value := element.(type) {
+		case uuidValuable:
+			out[i] = value.uuidValue()
+
+		case basetypes.StringValuable:
+			stringValue, stringDiags := value.ToStringValue(ctx)
+			diags.Append(stringDiags...)
+			out[i] = UUIDValue{StringValue: stringValue}
+
+		default:
+			diags.AddError(
+				"Invalid UUID Element Type",
+				fmt.Sprintf("The %s is not a string, so can not be a UUID. ", describe(i, NewUUIDNull()))+
+					"This is always an error in the provider. Please report the following to the provider developers:\n\n"+
+					fmt.Sprintf("Element Type: %T", element),
+			)
+
+			continue
+		}
+
+		_, valueDiags := out[i].ValueUUID()
+		for _, d := range valueDiags {
+			diags.Append(elementDiagnostic(describe(i, out[i]), d))
+		}
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return out, diags
+}
+
+// uuidElementsEqual returns true if both sets of collection elements parse to
+// the same 16 byte UUIDs, optionally ignoring their order. Null, unknown and
+// invalid elements are never considered equal.
+func uuidElementsEqual(ctx context.Context, a []attr.Value, b []attr.Value, ignoreOrder bool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	aUUIDs, ok := elementsToBytes(ctx, a)
+	if !ok {
+		return false
+	}
+
+	bUUIDs, ok := elementsToBytes(ctx, b)
+	if !ok {
+		return false
+	}
+
+	if ignoreOrder {
+		compare := func(x, y [16]byte) int {
+			return bytes.Compare(x[:], y[:])
+		}
+
+		slices.SortFunc(aUUIDs, compare)
+		slices.SortFunc(bUUIDs, compare)
+	}
+
+	return slices.Equal(aUUIDs, bUUIDs)
+}
+
+// elementsToBytes parses each known collection element as a UUID in any
+// textual format, returning false if an element can't be parsed.
+func elementsToBytes(ctx context.Context, elements []attr.Value) ([][16]byte, bool) {
+	out := make([][16]byte, len(elements))
+	for i, element := range elements {
+		formats := FormatAll
+
+		var stringValue basetypes.StringValue
+		switch value := element.(type) {
+		case uuidValuable:
+			uuidValue := value.uuidValue()
+			stringValue = uuidValue.StringValue
+			formats |= uuidValue.uuidType.Formats
+
+		case basetypes.StringValuable:
+			var diags diag.Diagnostics
+			stringValue, diags = value.ToStringValue(ctx)
+			if diags.HasError() {
+				return nil, false
+			}
+
+		default:
+			return nil, false
+		}
+
+		if stringValue.IsNull() || stringValue.IsUnknown() {
+			return nil, false
+		}
+
+		uuid, err := parseUUID(stringValue.ValueString(), formats, false)
+		if err != nil {
+			return nil, false
+		}
+
+		out[i] = uuid
+	}
+
+	return out, true
+}
+
+// uuidsToElements converts UUIDValues to elements of a collection with an
+// ElementType of UUIDType{}.
+func uuidsToElements(uuids []UUIDValue) []attr.Value {
+	elements := make([]attr.Value, len(uuids))
+	for i, value := range uuids {
+		elements[i] = UUIDValue{StringValue: value.StringValue}
+	}
+
+	return elements
+}
+
+// uuidsToBytes returns the 16 byte representation of each UUID, which must
+// already be validated, reporting null and unknown UUIDs as invalid.
+func uuidsToBytes(uuids []UUIDValue, describe func(int, UUIDValue) string) ([][16]byte, diag.Diagnostics) {
+	if uuids == nil {
+		return nil, nil
+	}
+
+	var diags diag.Diagnostics
+
+	out := make([][16]byte, len(uuids))
+	for i, value := range uuids {
+		if value.IsNull() || value.IsUnknown() {
+			diags.Append(missingElementError(describe(i, value), value))
+
+			continue
+		}
+
+		out[i], _ = value.ValueUUID()
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return out, diags
+}
+
+// bytesToUUIDs converts 16 byte UUIDs to known UUIDValues in the canonical
+// format.
+func bytesToUUIDs(uuids [][16]byte) []UUIDValue {
+	values := make([]UUIDValue, len(uuids))
+	for i, uuid := range uuids {
+		values[i] = NewUUIDValueFromBytes(uuid)
+	}
+
+	return values
+}
+
+// elementDiagnostic returns the diagnostic with its detail prefixed by the
+// description of the collection element it applies to.
+func elementDiagnostic(element string, d diag.Diagnostic) diag.Diagnostic {
+	detail := fmt.Sprintf("The %s is invalid.\n\n%s", element, d.Detail())
+	if d.Severity() == diag.SeverityWarning {
+		return diag.NewWarningDiagnostic(d.Summary(), detail)
+	}
+
+	return diag.NewErrorDiagnostic(d.Summary(), detail)
+}
+
+// missingElementError returns the diagnostic raised when a null or unknown
+// element can't be converted to its 16 byte representation.
+func missingElementError(element string, value UUIDValue) diag.Diagnostic {
+	state := "null"
+	if value.IsUnknown() {
+		state = "unknown"
+	}
+
+	return diag.NewErrorDiagnostic(
+		"Invalid UUID Element",
+		fmt.Sprintf("The %s is %s, so can not be converted to a 16 byte UUID.", element, state),
+	)
+}
@@ -0,0 +1,452 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidtypes_test
+
+import (
+	// Standard Library Imports
+	"context"
+	"fmt"
+	"testing"
+
+	// External Imports
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidtypes"
+)
+
+// invalidElementDiags returns the diagnostics expected for an invalid element
+// of a collection of UUIDs.
+func invalidElementDiags(element string, value string) diag.Diagnostics {
+	_, valueDiags := uuidtypes.NewUUIDValue(value).ValueUUID()
+
+	diags := make(diag.Diagnostics, len(valueDiags))
+	for i, d := range valueDiags {
+		diags[i] = diag.NewErrorDiagnostic(d.Summary(), "The "+element+" is invalid.\n\n"+d.Detail())
+	}
+
+	return diags
+}
+
+func TestListValueToUUIDs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		list          types.List
+		expected      []uuidtypes.UUIDValue
+		expectedDiags diag.Diagnostics
+	}{
+		{
+			name:     "null",
+			list:     types.ListNull(uuidtypes.UUIDType{}),
+			expected: nil,
+		},
+		{
+			name:     "unknown",
+			list:     types.ListUnknown(uuidtypes.UUIDType{}),
+			expected: nil,
+		},
+		{
+			name: "uuid-elements",
+			list: types.ListValueMust(uuidtypes.UUIDType{}, []attr.Value{
+				uuidtypes.NewUUIDValue(valueUUIDv4),
+				uuidtypes.NewUUIDNull(),
+				uuidtypes.NewUUIDValue(valueUUIDNil),
+			}),
+			expected: []uuidtypes.UUIDValue{
+				uuidtypes.NewUUIDValue(valueUUIDv4),
+				uuidtypes.NewUUIDNull(),
+				uuidtypes.NewUUIDValue(valueUUIDNil),
+			},
+		},
+		{
+			name: "string-elements",
+			list: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue(valueUUIDv4Upper),
+				types.StringUnknown(),
+			}),
+			expected: []uuidtypes.UUIDValue{
+				uuidtypes.NewUUIDValue(valueUUIDv4Upper),
+				uuidtypes.NewUUIDUnknown(),
+			},
+		},
+		{
+			name: "invalid-element",
+			list: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue(valueUUIDv4),
+				types.StringValue(valueInvalid),
+			}),
+			expected:      nil,
+			expectedDiags: invalidElementDiags("list element at index 1", valueInvalid),
+		},
+		{
+			name: "versioned-elements",
+			list: types.ListValueMust(uuidtypes.UUIDv4Type{}, []attr.Value{
+				uuidtypes.NewUUIDv4Value(valueUUIDv4),
+			}),
+			expected: []uuidtypes.UUIDValue{
+				uuidtypes.NewUUIDValue(valueUUIDv4),
+			},
+		},
+		{
+			name: "versioned-element-other-version",
+			list: types.ListValueMust(uuidtypes.UUIDv4Type{}, []attr.Value{
+				uuidtypes.NewUUIDv4Value(valueUUIDv4),
+				uuidtypes.NewUUIDv4Value(valueUUIDv1),
+			}),
+			expected: nil,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid UUID Version",
+					"The list element at index 1 is invalid.\n\n"+
+						"A Version 4 UUID was expected, but a Version 1 UUID was provided.\n\n"+
+						fmt.Sprintf("Provided Value: %q", valueUUIDv1),
+				),
+			},
+		},
+		{
+			name: "invalid-element-type",
+			list: types.ListValueMust(types.Int64Type, []attr.Value{
+				types.Int64Value(1),
+			}),
+			expected: nil,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid UUID Element Type",
+					"The list element at index 0 is not a string, so can not be a UUID. "+
+						"This is always an error in the provider. Please report the following to the provider developers:\n\n"+
+						"Element Type: basetypes.Int64Value",
+				),
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, gotDiags := uuidtypes.ListValueToUUIDs(context.Background(), testcase.list)
+			if diff := cmp.Diff(got, testcase.expected); diff != "" {
+				t.Errorf("ListValueToUUIDs()\ngot     : %v\nexpected: %v\ndiff    : %s\n", got, testcase.expected, diff)
+			}
+
+			if diff := cmp.Diff(gotDiags, testcase.expectedDiags); diff != "" {
+				t.Errorf("ListValueToUUIDs() diag.Diagnostics\ngot     : %v\nexpected: %v\ndiff    : %s\n", gotDiags, testcase.expectedDiags, diff)
+			}
+		})
+	}
+}
+
+func TestSetValueToUUIDs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		set           types.Set
+		expected      []uuidtypes.UUIDValue
+		expectedDiags diag.Diagnostics
+	}{
+		{
+			name:     "null",
+			set:      types.SetNull(uuidtypes.UUIDType{}),
+			expected: nil,
+		},
+		{
+			name: "uuid-elements",
+			set: types.SetValueMust(uuidtypes.UUIDType{}, []attr.Value{
+				uuidtypes.NewUUIDValue(valueUUIDv4),
+				uuidtypes.NewUUIDValue(valueUUIDv5),
+			}),
+			expected: []uuidtypes.UUIDValue{
+				uuidtypes.NewUUIDValue(valueUUIDv4),
+				uuidtypes.NewUUIDValue(valueUUIDv5),
+			},
+		},
+		{
+			name: "invalid-element",
+			set: types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue(valueInvalid),
+			}),
+			expected:      nil,
+			expectedDiags: invalidElementDiags(`set element "`+valueInvalid+`"`, valueInvalid),
+		},
+	}
+
+	for _, testcase := range tests {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, gotDiags := uuidtypes.SetValueToUUIDs(context.Background(), testcase.set)
+			if diff := cmp.Diff(got, testcase.expected); diff != "" {
+				t.Errorf("SetValueToUUIDs()\ngot     : %v\nexpected: %v\ndiff    : %s\n", got, testcase.expected, diff)
+			}
+
+			if diff := cmp.Diff(gotDiags, testcase.expectedDiags); diff != "" {
+				t.Errorf("SetValueToUUIDs() diag.Diagnostics\ngot     : %v\nexpected: %v\ndiff    : %s\n", gotDiags, testcase.expectedDiags, diff)
+			}
+		})
+	}
+}
+
+func TestMapValueToUUIDs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		m             types.Map
+		expected      map[string]uuidtypes.UUIDValue
+		expectedDiags diag.Diagnostics
+	}{
+		{
+			name:     "null",
+			m:        types.MapNull(uuidtypes.UUIDType{}),
+			expected: nil,
+		},
+		{
+			name: "uuid-elements",
+			m: types.MapValueMust(uuidtypes.UUIDType{}, map[string]attr.Value{
+				"a": uuidtypes.NewUUIDValue(valueUUIDv4),
+				"b": uuidtypes.NewUUIDNull(),
+			}),
+			expected: map[string]uuidtypes.UUIDValue{
+				"a": uuidtypes.NewUUIDValue(valueUUIDv4),
+				"b": uuidtypes.NewUUIDNull(),
+			},
+		},
+		{
+			name: "invalid-elements-in-key-order",
+			m: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"c": types.StringValue(valueInvalidLength),
+				"a": types.StringValue(valueInvalid),
+				"b": types.StringValue(valueUUIDv4),
+			}),
+			expected: nil,
+			expectedDiags: append(
+				invalidElementDiags(`map element with key "a"`, valueInvalid),
+				invalidElementDiags(`map element with key "c"`, valueInvalidLength)...,
+			),
+		},
+	}
+
+	for _, testcase := range tests {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, gotDiags := uuidtypes.MapValueToUUIDs(context.Background(), testcase.m)
+			if diff := cmp.Diff(got, testcase.expected); diff != "" {
+				t.Errorf("MapValueToUUIDs()\ngot     : %v\nexpected: %v\ndiff    : %s\n", got, testcase.expected, diff)
+			}
+
+			if diff := cmp.Diff(gotDiags, testcase.expectedDiags); diff != "" {
+				t.Errorf("MapValueToUUIDs() diag.Diagnostics\ngot     : %v\nexpected: %v\ndiff    : %s\n", gotDiags, testcase.expectedDiags, diff)
+			}
+		})
+	}
+}
+
+func TestUUIDsToListValue(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		uuids    []uuidtypes.UUIDValue
+		expected types.List
+	}{
+		{
+			name:     "empty",
+			uuids:    nil,
+			expected: types.ListValueMust(uuidtypes.UUIDType{}, []attr.Value{}),
+		},
+		{
+			name: "elements",
+			uuids: []uuidtypes.UUIDValue{
+				uuidtypes.NewUUIDValue(valueUUIDv4),
+				uuidValueFromType(uuidtypes.UUIDType{DisallowNil: true}, valueUUIDv5),
+				uuidtypes.NewUUIDUnknown(),
+			},
+			expected: types.ListValueMust(uuidtypes.UUIDType{}, []attr.Value{
+				uuidtypes.NewUUIDValue(valueUUIDv4),
+				uuidtypes.NewUUIDValue(valueUUIDv5),
+				uuidtypes.NewUUIDUnknown(),
+			}),
+		},
+	}
+
+	for _, testcase := range tests {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := uuidtypes.UUIDsToListValue(context.Background(), testcase.uuids)
+			if diags.HasError() {
+				t.Fatalf("UUIDsToListValue() unexpected diagnostics: %v", diags)
+			}
+
+			if !got.Equal(testcase.expected) {
+				t.Errorf("UUIDsToListValue()\ngot     : %v\nexpected: %v\n", got, testcase.expected)
+			}
+		})
+	}
+}
+
+func TestUUIDsToMapValue(t *testing.T) {
+	t.Parallel()
+
+	got, diags := uuidtypes.UUIDsToMapValue(context.Background(), map[string]uuidtypes.UUIDValue{
+		"a": uuidtypes.NewUUIDValue(valueUUIDv4),
+		"b": uuidtypes.NewUUIDNull(),
+	})
+	if diags.HasError() {
+		t.Fatalf("UUIDsToMapValue() unexpected diagnostics: %v", diags)
+	}
+
+	expected := types.MapValueMust(uuidtypes.UUIDType{}, map[string]attr.Value{
+		"a": uuidtypes.NewUUIDValue(valueUUIDv4),
+		"b": uuidtypes.NewUUIDNull(),
+	})
+	if !got.Equal(expected) {
+		t.Errorf("UUIDsToMapValue()\ngot     : %v\nexpected: %v\n", got, expected)
+	}
+}
+
+func TestListValueToBytes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		list          types.List
+		expected      [][16]byte
+		expectedDiags diag.Diagnostics
+	}{
+		{
+			name:     "null",
+			list:     types.ListNull(uuidtypes.UUIDType{}),
+			expected: nil,
+		},
+		{
+			name: "elements",
+			list: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue(valueUUIDv4Upper),
+				types.StringValue(valueUUIDNil),
+			}),
+			expected: [][16]byte{bytesUUIDv4, uuidtypes.NilUUID},
+		},
+		{
+			name: "null-element",
+			list: types.ListValueMust(uuidtypes.UUIDType{}, []attr.Value{
+				uuidtypes.NewUUIDValue(valueUUIDv4),
+				uuidtypes.NewUUIDNull(),
+			}),
+			expected: nil,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid UUID Element",
+					"The list element at index 1 is null, so can not be converted to a 16 byte UUID.",
+				),
+			},
+		},
+		{
+			name: "unknown-element",
+			list: types.ListValueMust(uuidtypes.UUIDType{}, []attr.Value{
+				uuidtypes.NewUUIDUnknown(),
+			}),
+			expected: nil,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid UUID Element",
+					"The list element at index 0 is unknown, so can not be converted to a 16 byte UUID.",
+				),
+			},
+		},
+		{
+			name: "invalid-element",
+			list: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue(valueInvalid),
+			}),
+			expected:      nil,
+			expectedDiags: invalidElementDiags("list element at index 0", valueInvalid),
+		},
+	}
+
+	for _, testcase := range tests {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, gotDiags := uuidtypes.ListValueToBytes(context.Background(), testcase.list)
+			if diff := cmp.Diff(got, testcase.expected); diff != "" {
+				t.Errorf("ListValueToBytes()\ngot     : %v\nexpected: %v\ndiff    : %s\n", got, testcase.expected, diff)
+			}
+
+			if diff := cmp.Diff(gotDiags, testcase.expectedDiags); diff != "" {
+				t.Errorf("ListValueToBytes() diag.Diagnostics\ngot     : %v\nexpected: %v\ndiff    : %s\n", gotDiags, testcase.expectedDiags, diff)
+			}
+		})
+	}
+}
+
+func TestMapValueToBytes(t *testing.T) {
+	t.Parallel()
+
+	got, diags := uuidtypes.MapValueToBytes(context.Background(), types.MapValueMust(types.StringType, map[string]attr.Value{
+		"a": types.StringValue(valueUUIDv4),
+		"b": types.StringNull(),
+	}))
+
+	expectedDiags := diag.Diagnostics{
+		diag.NewErrorDiagnostic(
+			"Invalid UUID Element",
+			"The map element with key \"b\" is null, so can not be converted to a 16 byte UUID.",
+		),
+	}
+	if diff := cmp.Diff(diags, expectedDiags); diff != "" {
+		t.Errorf("MapValueToBytes() diag.Diagnostics\ngot     : %v\nexpected: %v\ndiff    : %s\n", diags, expectedDiags, diff)
+	}
+
+	if got != nil {
+		t.Errorf("MapValueToBytes()\ngot     : %v\nexpected: %v\n", got, nil)
+	}
+}
+
+func TestBytesToValue(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	elements := []attr.Value{
+		uuidtypes.NewUUIDValue(valueUUIDv4),
+		uuidtypes.NewUUIDValue(valueUUIDNil),
+	}
+
+	list, diags := uuidtypes.BytesToListValue(ctx, [][16]byte{bytesUUIDv4, uuidtypes.NilUUID})
+	if expected := types.ListValueMust(uuidtypes.UUIDType{}, elements); diags.HasError() || !list.Equal(expected) {
+		t.Errorf("BytesToListValue()\ngot     : %v\nexpected: %v\ndiags   : %v\n", list, expected, diags)
+	}
+
+	set, diags := uuidtypes.BytesToSetValue(ctx, [][16]byte{bytesUUIDv4, uuidtypes.NilUUID})
+	if expected := types.SetValueMust(uuidtypes.UUIDType{}, elements); diags.HasError() || !set.Equal(expected) {
+		t.Errorf("BytesToSetValue()\ngot     : %v\nexpected: %v\ndiags   : %v\n", set, expected, diags)
+	}
+
+	m, diags := uuidtypes.BytesToMapValue(ctx, map[string][16]byte{"a": bytesUUIDv4})
+	if expected := types.MapValueMust(uuidtypes.UUIDType{}, map[string]attr.Value{"a": elements[0]}); diags.HasError() || !m.Equal(expected) {
+		t.Errorf("BytesToMapValue()\ngot     : %v\nexpected: %v\ndiags   : %v\n", m, expected, diags)
+	}
+}
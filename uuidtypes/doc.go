@@ -8,7 +8,8 @@
 
 // Package uuidtypes implements a terraform-plugin-framework attr.Type and
 // attr.Value for Universally Unique IDentifiers (UUIDs) as defined in
-// [RFC 4122].
+// [RFC 9562], which obsoletes [RFC 4122].
 //
-// [RFC 4122]: https://tools.ietf.org/html/rfc4122
+// [RFC 9562]: https://www.rfc-editor.org/rfc/rfc9562
+// [RFC 4122]: https://www.rfc-editor.org/rfc/rfc4122
 package uuidtypes
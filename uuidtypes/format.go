@@ -0,0 +1,116 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidtypes
+
+import (
+	// Standard Library Imports
+	"encoding/base32"
+	"encoding/base64"
+	"strings"
+)
+
+var (
+	// base64URLEncoding is the encoding used by FormatBase64URL.
+	base64URLEncoding = base64.RawURLEncoding
+
+	// base32Encoding is the encoding used by FormatBase32.
+	base32Encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+)
+
+// Format is a set of textual UUID formats. Formats can be combined using a
+// bitwise OR, for example, FormatCanonical | FormatBraced.
+type Format uint8
+
+const (
+	// FormatCanonical is the hyphenated 8-4-4-4-12 hex digit format, for
+	// example, 7b16fd41-cc23-4ef7-8aa9-c598350ccd18.
+	FormatCanonical Format = 1 << iota
+	// FormatBraced is the canonical format surrounded by braces, for example,
+	// {7b16fd41-cc23-4ef7-8aa9-c598350ccd18}.
+	FormatBraced
+	// FormatURN is the canonical format prefixed by the URN namespace, for
+	// example, urn:uuid:7b16fd41-cc23-4ef7-8aa9-c598350ccd18.
+	FormatURN
+	// FormatHex is the 32 hex digit format without hyphens, for example,
+	// 7b16fd41cc234ef78aa9c598350ccd18.
+	FormatHex
+	// FormatBase64URL is the 22 character unpadded base64url encoding of the
+	// 16 bytes, as defined in RFC 4648, for example, exb9QcwjTveKqcWYNQzNGA.
+	FormatBase64URL
+	// FormatBase32 is the 26 character unpadded base32 encoding of the 16
+	// bytes, as defined in RFC 4648, for example, PMLP2QOMENHPPCVJYWMDKDGNDA.
+	// Letter case is ignored when parsing.
+	FormatBase32
+)
+
+const (
+	// FormatAll is the set of every format using hex digits, that is, the
+	// canonical, braced, URN and hex formats.
+	FormatAll = FormatCanonical | FormatBraced | FormatURN | FormatHex
+
+	// FormatEncoded is the set of binary-to-text encodings. These are not
+	// included in FormatAll, as short identifiers can be mistaken for an
+	// encoded UUID, so must be explicitly accepted.
+	FormatEncoded = FormatBase64URL | FormatBase32
+)
+
+// formatNames maps each format to its human-friendly name.
+var formatNames = []struct {
+	format Format
+	name   string
+}{
+	{format: FormatCanonical, name: "canonical"},
+	{format: FormatBraced, name: "braced"},
+	{format: FormatURN, name: "urn"},
+	{format: FormatHex, name: "hex"},
+	{format: FormatBase64URL, name: "base64url"},
+	{format: FormatBase32, name: "base32"},
+}
+
+// String returns a human-friendly version of the format set.
+func (f Format) String() string {
+	var names []string
+	for _, n := range formatNames {
+		if f&n.format != 0 {
+			names = append(names, n.name)
+		}
+	}
+
+	return strings.Join(names, "|")
+}
+
+// orDefault returns the format set, or FormatCanonical if no format is set.
+func (f Format) orDefault() Format {
+	if f == 0 {
+		return FormatCanonical
+	}
+
+	return f
+}
+
+// Encode returns the textual representation of the UUID in the given format.
+// Hex digits are lowercase, while base32 uses the uppercase RFC 4648 alphabet.
+// If the format is not a single known format, the canonical format is used.
+func Encode(uuid [16]byte, format Format) string {
+	canonical := canonicalString(uuid)
+	switch format {
+	case FormatBraced:
+		return "{" + canonical + "}"
+	case FormatURN:
+		return urnPrefix + canonical
+	case FormatHex:
+		return strings.ReplaceAll(canonical, "-", "")
+	case FormatBase64URL:
+		return base64URLEncoding.EncodeToString(uuid[:])
+	case FormatBase32:
+		return base32Encoding.EncodeToString(uuid[:])
+	default:
+		return canonical
+	}
+}
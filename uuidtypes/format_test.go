@@ -0,0 +1,119 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidtypes_test
+
+import (
+	// Standard Library Imports
+	"testing"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidtypes"
+)
+
+func TestFormat_String(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		format   uuidtypes.Format
+		expected string
+	}{
+		{
+			name:     "none",
+			format:   0,
+			expected: "",
+		},
+		{
+			name:     "canonical",
+			format:   uuidtypes.FormatCanonical,
+			expected: "canonical",
+		},
+		{
+			name:     "all",
+			format:   uuidtypes.FormatAll,
+			expected: "canonical|braced|urn|hex",
+		},
+		{
+			name:     "encoded",
+			format:   uuidtypes.FormatEncoded,
+			expected: "base64url|base32",
+		},
+	}
+	for _, testcase := range tests {
+		testcase := testcase
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := testcase.format.String(); got != testcase.expected {
+				t.Errorf("String()\ngot     : %v\nexpected: %v\n", got, testcase.expected)
+			}
+		})
+	}
+}
+
+func TestEncode(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		format   uuidtypes.Format
+		expected string
+	}{
+		{
+			name:     "default",
+			format:   0,
+			expected: valueUUIDv4,
+		},
+		{
+			name:     "canonical",
+			format:   uuidtypes.FormatCanonical,
+			expected: valueUUIDv4,
+		},
+		{
+			name:     "braced",
+			format:   uuidtypes.FormatBraced,
+			expected: valueUUIDv4Braced,
+		},
+		{
+			name:     "urn",
+			format:   uuidtypes.FormatURN,
+			expected: valueUUIDv4URN,
+		},
+		{
+			name:     "hex",
+			format:   uuidtypes.FormatHex,
+			expected: valueUUIDv4Hex,
+		},
+		{
+			name:     "base64url",
+			format:   uuidtypes.FormatBase64URL,
+			expected: valueUUIDv4Base64URL,
+		},
+		{
+			name:     "base32",
+			format:   uuidtypes.FormatBase32,
+			expected: valueUUIDv4Base32,
+		},
+		{
+			name:     "multiple",
+			format:   uuidtypes.FormatBraced | uuidtypes.FormatURN,
+			expected: valueUUIDv4,
+		},
+	}
+	for _, testcase := range tests {
+		testcase := testcase
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := uuidtypes.Encode(bytesUUIDv4, testcase.format); got != testcase.expected {
+				t.Errorf("Encode()\ngot     : %v\nexpected: %v\n", got, testcase.expected)
+			}
+		})
+	}
+}
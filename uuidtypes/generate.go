@@ -0,0 +1,134 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidtypes
+
+import (
+	// Standard Library Imports
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"time"
+
+	// External Imports
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// Namespaces defined by RFC 9562 for use with name-based UUIDs.
+var (
+	// NamespaceDNS is the namespace for fully qualified domain names.
+	NamespaceDNS = [16]byte{0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+	// NamespaceURL is the namespace for URLs.
+	NamespaceURL = [16]byte{0x6b, 0xa7, 0xb8, 0x11, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+	// NamespaceOID is the namespace for ISO object identifiers.
+	NamespaceOID = [16]byte{0x6b, 0xa7, 0xb8, 0x12, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+	// NamespaceX500 is the namespace for X.500 distinguished names.
+	NamespaceX500 = [16]byte{0x6b, 0xa7, 0xb8, 0x14, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+)
+
+// GenerateV3 returns the name-based Version 3 UUID of the name within the
+// namespace, using MD5 hashing. The same namespace and name always generate the
+// same UUID. Prefer GenerateV5 unless compatibility with existing Version 3
+// UUIDs is required.
+func GenerateV3(namespace [16]byte, name string) [16]byte {
+	return generateNameBased(md5.New(), namespace, name, Version3)
+}
+
+// GenerateV4 returns a new random Version 4 UUID.
+func GenerateV4() ([16]byte, error) {
+	var uuid [16]byte
+	if _, err := rand.Read(uuid[:]); err != nil {
+		return [16]byte{}, err
+	}
+
+	return withVersion(uuid, Version4), nil
+}
+
+// GenerateV7 returns a new Version 7 UUID, embedding the current Unix time in
+// milliseconds followed by random bits. Version 7 UUIDs sort by creation time,
+// so are better suited than Version 4 UUIDs for use as database keys.
+func GenerateV7() ([16]byte, error) {
+	var uuid [16]byte
+	if _, err := rand.Read(uuid[6:]); err != nil {
+		return [16]byte{}, err
+	}
+
+	var unixMilli [8]byte
+	binary.BigEndian.PutUint64(unixMilli[:], uint64(time.Now().UnixMilli()))
+	copy(uuid[0:6], unixMilli[2:])
+
+	return withVersion(uuid, Version7), nil
+}
+
+// GenerateV5 returns the name-based Version 5 UUID of the name within the
+// namespace, using SHA-1 hashing. The same namespace and name always generate
+// the same UUID.
+func GenerateV5(namespace [16]byte, name string) [16]byte {
+	return generateNameBased(sha1.New(), namespace, name, Version5)
+}
+
+// GenerateUUIDValue returns a UUID with a newly generated value of the given
+// version. Only Version 4 and Version 7 UUIDs can be generated without further
+// input.
+func GenerateUUIDValue(version Version) (UUIDValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var uuid [16]byte
+	var err error
+	switch version {
+	case Version4:
+		uuid, err = GenerateV4()
+	case Version7:
+		uuid, err = GenerateV7()
+	default:
+		diags.AddError(
+			"UUID Generation Error",
+			"An unexpected error occurred while generating a UUID. "+
+				"Only Version 4 and Version 7 UUIDs can be generated. "+
+				"Please report this to the provider developers.\n\n"+
+				fmt.Sprintf("Requested Version: %d", version),
+		)
+
+		return NewUUIDUnknown(), diags
+	}
+
+	if err != nil {
+		diags.AddError(
+			"UUID Generation Error",
+			"An unexpected error occurred while reading random data to generate a UUID.\n\n"+
+				fmt.Sprintf("Error: %s", err.Error()),
+		)
+
+		return NewUUIDUnknown(), diags
+	}
+
+	return NewUUIDValue(canonicalString(uuid)), diags
+}
+
+// generateNameBased returns the UUID formed from the hash of the namespace
+// followed by the name.
+func generateNameBased(h hash.Hash, namespace [16]byte, name string, version Version) [16]byte {
+	h.Write(namespace[:])
+	h.Write([]byte(name))
+
+	var uuid [16]byte
+	copy(uuid[:], h.Sum(nil))
+
+	return withVersion(uuid, version)
+}
+
+// withVersion sets the version and RFC 9562 variant bits of the UUID.
+func withVersion(uuid [16]byte, version Version) [16]byte {
+	uuid[6] = uuid[6]&0x0f | byte(version)<<4
+	uuid[8] = uuid[8]&0x3f | 0x80
+
+	return uuid
+}
@@ -0,0 +1,222 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidtypes_test
+
+import (
+	// Standard Library Imports
+	"testing"
+	"time"
+
+	// External Imports
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidtypes"
+)
+
+func TestGenerateV3(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		namespace [16]byte
+		value     string
+		expected  string
+	}{
+		{
+			// RFC 9562, Appendix A.2.
+			name:      "rfc9562-dns",
+			namespace: uuidtypes.NamespaceDNS,
+			value:     "www.example.com",
+			expected:  "5df41881-3aed-3515-88a7-2f4a814cf09e",
+		},
+		{
+			name:      "url",
+			namespace: uuidtypes.NamespaceURL,
+			value:     "https://example.com",
+			expected:  "68794df6-5e20-385f-ab08-bb73f8a433cb",
+		},
+		{
+			name:      "custom",
+			namespace: bytesUUIDv4,
+			value:     "name",
+			expected:  "6585f906-b332-396a-87db-c82b4139389f",
+		},
+	}
+	for _, testcase := range tests {
+		testcase := testcase
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := uuidtypes.Encode(uuidtypes.GenerateV3(testcase.namespace, testcase.value), uuidtypes.FormatCanonical)
+			if got != testcase.expected {
+				t.Errorf("GenerateV3()\ngot     : %v\nexpected: %v\n", got, testcase.expected)
+			}
+		})
+	}
+}
+
+func TestGenerateV5(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		namespace [16]byte
+		value     string
+		expected  string
+	}{
+		{
+			// RFC 9562, Appendix A.4.
+			name:      "rfc9562-dns",
+			namespace: uuidtypes.NamespaceDNS,
+			value:     "www.example.com",
+			expected:  "2ed6657d-e927-568b-95e1-2665a8aea6a2",
+		},
+		{
+			name:      "url",
+			namespace: uuidtypes.NamespaceURL,
+			value:     "https://example.com",
+			expected:  "4fd35a71-71ef-5a55-a9d9-aa75c889a6d0",
+		},
+		{
+			name:      "oid",
+			namespace: uuidtypes.NamespaceOID,
+			value:     "1.3.6.1",
+			expected:  "1447fa61-5277-5fef-a9b3-fbc6e44f4af3",
+		},
+		{
+			name:      "x500",
+			namespace: uuidtypes.NamespaceX500,
+			value:     "cn=example",
+			expected:  "3ecc4f45-80bb-593a-be98-00e146377827",
+		},
+		{
+			name:      "custom",
+			namespace: bytesUUIDv4,
+			value:     "name",
+			expected:  "1aa000ec-cf37-5892-a5d8-2897da781aac",
+		},
+	}
+	for _, testcase := range tests {
+		testcase := testcase
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := uuidtypes.Encode(uuidtypes.GenerateV5(testcase.namespace, testcase.value), uuidtypes.FormatCanonical)
+			if got != testcase.expected {
+				t.Errorf("GenerateV5()\ngot     : %v\nexpected: %v\n", got, testcase.expected)
+			}
+		})
+	}
+}
+
+func TestGenerateV4(t *testing.T) {
+	t.Parallel()
+
+	uuid, err := uuidtypes.GenerateV4()
+	if err != nil {
+		t.Fatalf("GenerateV4()\ngot error: %s\n", err)
+	}
+	if got := uuidtypes.VersionOf(uuid); got != uuidtypes.Version4 {
+		t.Errorf("VersionOf(GenerateV4())\ngot     : %v\nexpected: %v\n", got, uuidtypes.Version4)
+	}
+	if got := uuidtypes.VariantOf(uuid); got != uuidtypes.VariantRFC9562 {
+		t.Errorf("VariantOf(GenerateV4())\ngot     : %v\nexpected: %v\n", got, uuidtypes.VariantRFC9562)
+	}
+
+	other, err := uuidtypes.GenerateV4()
+	if err != nil {
+		t.Fatalf("GenerateV4()\ngot error: %s\n", err)
+	}
+	if uuid == other {
+		t.Errorf("GenerateV4()\ngot the same UUID twice: %v\n", uuid)
+	}
+}
+
+func TestGenerateV7(t *testing.T) {
+	t.Parallel()
+
+	before := time.Now().Truncate(time.Millisecond)
+	uuid, err := uuidtypes.GenerateV7()
+	if err != nil {
+		t.Fatalf("GenerateV7()\ngot error: %s\n", err)
+	}
+	after := time.Now()
+
+	if got := uuidtypes.VersionOf(uuid); got != uuidtypes.Version7 {
+		t.Errorf("VersionOf(GenerateV7())\ngot     : %v\nexpected: %v\n", got, uuidtypes.Version7)
+	}
+	if got := uuidtypes.VariantOf(uuid); got != uuidtypes.VariantRFC9562 {
+		t.Errorf("VariantOf(GenerateV7())\ngot     : %v\nexpected: %v\n", got, uuidtypes.VariantRFC9562)
+	}
+
+	timestamp, ok := uuidtypes.TimestampOf(uuid)
+	if !ok || timestamp.Before(before) || timestamp.After(after) {
+		t.Errorf("TimestampOf(GenerateV7())\ngot     : %v\nexpected: between %v and %v\n", timestamp, before, after)
+	}
+}
+
+func TestGenerateUUIDValue(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		version       uuidtypes.Version
+		expectedDiags diag.Diagnostics
+	}{
+		{
+			name:    "version-4",
+			version: uuidtypes.Version4,
+		},
+		{
+			name:    "version-7",
+			version: uuidtypes.Version7,
+		},
+		{
+			name:    "version-5",
+			version: uuidtypes.Version5,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"UUID Generation Error",
+					"An unexpected error occurred while generating a UUID. "+
+						"Only Version 4 and Version 7 UUIDs can be generated. "+
+						"Please report this to the provider developers.\n\n"+
+						"Requested Version: 5",
+				),
+			},
+		},
+	}
+	for _, testcase := range tests {
+		testcase := testcase
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			value, diags := uuidtypes.GenerateUUIDValue(testcase.version)
+			if diff := cmp.Diff(diags, testcase.expectedDiags); diff != "" {
+				t.Fatalf("GenerateUUIDValue() diagnostics\ngot     : %v\nexpected: %v\ndiff    : %s\n", diags, testcase.expectedDiags, diff)
+			}
+			if diags.HasError() {
+				if !value.IsUnknown() {
+					t.Errorf("GenerateUUIDValue()\ngot     : %v\nexpected: unknown value\n", value)
+				}
+
+				return
+			}
+
+			uuid, uuidDiags := value.ValueUUID()
+			if uuidDiags.HasError() {
+				t.Fatalf("ValueUUID()\ngot unexpected diagnostics: %v\n", uuidDiags)
+			}
+			if got := uuidtypes.VersionOf(uuid); got != testcase.version {
+				t.Errorf("VersionOf(GenerateUUIDValue())\ngot     : %v\nexpected: %v\n", got, testcase.version)
+			}
+		})
+	}
+}
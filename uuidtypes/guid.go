@@ -0,0 +1,43 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidtypes
+
+// Microsoft GUIDs are commonly serialised in a mixed-endian byte order, where
+// the first three fields, the 4 byte Data1 and the 2 byte Data2 and Data3, are
+// little-endian, while the remaining 8 bytes, Data4, are in the order given.
+// This is the layout produced by the .NET Guid.ToByteArray method and the
+// Windows GUID structure in memory, whereas RFC 9562 defines the 16 bytes of
+// a UUID as big-endian.
+
+// GUIDBytes converts the RFC 9562 big-endian byte representation of a UUID to
+// the Microsoft GUID mixed-endian byte order. For example, the UUID
+// 00112233-4455-6677-8899-aabbccddeeff is ordered as the bytes
+// 33 22 11 00 55 44 77 66 88 99 aa bb cc dd ee ff.
+func GUIDBytes(uuid [16]byte) [16]byte {
+	return swapGUIDByteOrder(uuid)
+}
+
+// FromGUIDBytes converts a UUID in the Microsoft GUID mixed-endian byte order
+// to the RFC 9562 big-endian byte representation used by the rest of this
+// package.
+func FromGUIDBytes(guid [16]byte) [16]byte {
+	return swapGUIDByteOrder(guid)
+}
+
+// swapGUIDByteOrder reverses the byte order of the first three fields, which
+// converts between the RFC 9562 and Microsoft GUID byte orders in either
+// direction.
+func swapGUIDByteOrder(in [16]byte) [16]byte {
+	out := in
+	out[0], out[1], out[2], out[3] = in[3], in[2], in[1], in[0]
+	out[4], out[5] = in[5], in[4]
+	out[6], out[7] = in[7], in[6]
+
+	return out
+}
@@ -0,0 +1,156 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidtypes_test
+
+import (
+	// Standard Library Imports
+	"testing"
+
+	// External Imports
+	"github.com/google/go-cmp/cmp"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidtypes"
+)
+
+// guidTestCases are known pairs of Microsoft GUID mixed-endian bytes and the
+// canonical UUID they represent.
+var guidTestCases = []struct {
+	name      string
+	guidBytes [16]byte
+	uuid      string
+}{
+	{
+		name:      "sequential",
+		guidBytes: [16]byte{0x33, 0x22, 0x11, 0x00, 0x55, 0x44, 0x77, 0x66, 0x88, 0x99, 0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff},
+		uuid:      "00112233-4455-6677-8899-aabbccddeeff",
+	},
+	{
+		// .NET new Guid("6F9619FF-8B86-D011-B42D-00C04FC964FF").ToByteArray()
+		name:      "dotnet-to-byte-array",
+		guidBytes: [16]byte{0xff, 0x19, 0x96, 0x6f, 0x86, 0x8b, 0x11, 0xd0, 0xb4, 0x2d, 0x00, 0xc0, 0x4f, 0xc9, 0x64, 0xff},
+		uuid:      "6f9619ff-8b86-d011-b42d-00c04fc964ff",
+	},
+	{
+		name:      "v4",
+		guidBytes: [16]byte{0x8a, 0x14, 0x6f, 0xeb, 0x37, 0x66, 0x6b, 0x4c, 0xa4, 0xbb, 0xb7, 0x5b, 0x2a, 0x1b, 0x5a, 0x3c},
+		uuid:      valueUUIDv4,
+	},
+	{
+		name:      "nil",
+		guidBytes: [16]byte{},
+		uuid:      valueUUIDNil,
+	},
+}
+
+func TestGUIDBytes(t *testing.T) {
+	t.Parallel()
+
+	for _, testcase := range guidTestCases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			uuid, err := uuidtypes.Parse(testcase.uuid)
+			if err != nil {
+				t.Fatalf("Parse() unexpected error: %v", err)
+			}
+
+			got := uuidtypes.GUIDBytes(uuid)
+			if diff := cmp.Diff(got, testcase.guidBytes); diff != "" {
+				t.Errorf("GUIDBytes()\ngot     : %x\nexpected: %x\ndiff    : %s\n", got, testcase.guidBytes, diff)
+			}
+
+			if roundTrip := uuidtypes.FromGUIDBytes(got); roundTrip != uuid {
+				t.Errorf("FromGUIDBytes()\ngot     : %x\nexpected: %x\n", roundTrip, uuid)
+			}
+		})
+	}
+}
+
+func TestNewUUIDValueFromGUIDBytes(t *testing.T) {
+	t.Parallel()
+
+	for _, testcase := range guidTestCases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := uuidtypes.NewUUIDValueFromGUIDBytes(testcase.guidBytes)
+			expected := uuidtypes.NewUUIDValue(testcase.uuid)
+			if diff := cmp.Diff(got, expected); diff != "" {
+				t.Errorf("NewUUIDValueFromGUIDBytes()\ngot     : %v\nexpected: %v\ndiff    : %s\n", got, expected, diff)
+			}
+		})
+	}
+}
+
+func TestNewUUIDValueFromBytes(t *testing.T) {
+	t.Parallel()
+
+	got := uuidtypes.NewUUIDValueFromBytes(bytesUUIDv4)
+	expected := uuidtypes.NewUUIDValue(valueUUIDv4)
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("NewUUIDValueFromBytes()\ngot     : %v\nexpected: %v\ndiff    : %s\n", got, expected, diff)
+	}
+}
+
+func TestUUIDValue_ValueGUIDBytes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		value         uuidtypes.UUIDValue
+		expected      [16]byte
+		expectedDiags bool
+	}{
+		{
+			name:  "null",
+			value: uuidtypes.NewUUIDNull(),
+		},
+		{
+			name:  "unknown",
+			value: uuidtypes.NewUUIDUnknown(),
+		},
+		{
+			name:     "value",
+			value:    uuidtypes.NewUUIDValue(valueUUIDv4),
+			expected: [16]byte{0x8a, 0x14, 0x6f, 0xeb, 0x37, 0x66, 0x6b, 0x4c, 0xa4, 0xbb, 0xb7, 0x5b, 0x2a, 0x1b, 0x5a, 0x3c},
+		},
+		{
+			name:     "value-uppercase",
+			value:    uuidtypes.NewUUIDValue("6F9619FF-8B86-D011-B42D-00C04FC964FF"),
+			expected: [16]byte{0xff, 0x19, 0x96, 0x6f, 0x86, 0x8b, 0x11, 0xd0, 0xb4, 0x2d, 0x00, 0xc0, 0x4f, 0xc9, 0x64, 0xff},
+		},
+		{
+			name:          "value-invalid",
+			value:         uuidtypes.NewUUIDValue(valueInvalid),
+			expectedDiags: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, gotDiags := testcase.value.ValueGUIDBytes()
+			if diff := cmp.Diff(got, testcase.expected); diff != "" {
+				t.Errorf("ValueGUIDBytes()\ngot     : %x\nexpected: %x\ndiff    : %s\n", got, testcase.expected, diff)
+			}
+
+			if gotDiags.HasError() != testcase.expectedDiags {
+				t.Errorf("ValueGUIDBytes() diag.Diagnostics\ngot     : %v\nexpected error: %v\n", gotDiags, testcase.expectedDiags)
+			}
+		})
+	}
+}
@@ -0,0 +1,172 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidtypes
+
+import (
+	// Standard Library Imports
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	// External Imports
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Ensure Implementation matches the expected interfaces.
+var (
+	_ encoding.TextMarshaler     = UUIDValue{}
+	_ encoding.TextUnmarshaler   = (*UUIDValue)(nil)
+	_ encoding.BinaryMarshaler   = UUIDValue{}
+	_ encoding.BinaryUnmarshaler = (*UUIDValue)(nil)
+	_ json.Marshaler             = UUIDValue{}
+	_ json.Unmarshaler           = (*UUIDValue)(nil)
+)
+
+// ErrUnknownValue is returned when marshalling an unknown UUID value, as an
+// unknown value has no representation outside of Terraform.
+var ErrUnknownValue = errors.New("uuidtypes: cannot marshal an unknown UUID value")
+
+// jsonNull is the JSON representation of a null UUID value.
+var jsonNull = []byte("null")
+
+// MarshalText implements encoding.TextMarshaler. A known value is marshalled
+// in the canonical lowercase hyphenated format, while a null value is
+// marshalled as empty text. Marshalling an unknown value returns
+// ErrUnknownValue.
+func (u UUIDValue) MarshalText() ([]byte, error) {
+	uuid, err := u.marshalUUID()
+	if err != nil {
+		return nil, err
+	}
+
+	if u.IsNull() {
+		return []byte{}, nil
+	}
+
+	return []byte(Encode(uuid, FormatCanonical)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Empty text is unmarshalled
+// as a null value. Otherwise, the text is parsed in any of the formats in
+// FormatAll, or the formats accepted by the value's UUIDType, and stored in
+// the canonical format.
+func (u *UUIDValue) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		u.StringValue = basetypes.NewStringNull()
+
+		return nil
+	}
+
+	uuid, err := parseUUID(string(text), FormatAll|u.uuidType.Formats, false)
+	if err != nil {
+		return fmt.Errorf("uuidtypes: cannot unmarshal UUID value: %w", err)
+	}
+
+	u.StringValue = basetypes.NewStringValue(Encode(uuid, FormatCanonical))
+
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. A known value is
+// marshalled as its RFC 9562 big-endian 16 byte representation, while a null
+// value is marshalled as zero bytes. Marshalling an unknown value returns
+// ErrUnknownValue.
+func (u UUIDValue) MarshalBinary() ([]byte, error) {
+	uuid, err := u.marshalUUID()
+	if err != nil {
+		return nil, err
+	}
+
+	if u.IsNull() {
+		return []byte{}, nil
+	}
+
+	return uuid[:], nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. Zero bytes are
+// unmarshalled as a null value. Otherwise, the data must be the RFC 9562
+// big-endian 16 byte representation of a UUID, which is stored in the
+// canonical format.
+func (u *UUIDValue) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		u.StringValue = basetypes.NewStringNull()
+
+		return nil
+	}
+
+	if len(data) != 16 {
+		return fmt.Errorf("uuidtypes: cannot unmarshal UUID value: expected 16 bytes but got %d", len(data))
+	}
+
+	u.StringValue = basetypes.NewStringValue(Encode([16]byte(data), FormatCanonical))
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler. A known value is marshalled as a JSON
+// string in the canonical lowercase hyphenated format, while a null value is
+// marshalled as JSON null. Marshalling an unknown value returns
+// ErrUnknownValue.
+func (u UUIDValue) MarshalJSON() ([]byte, error) {
+	uuid, err := u.marshalUUID()
+	if err != nil {
+		return nil, err
+	}
+
+	if u.IsNull() {
+		return jsonNull, nil
+	}
+
+	return json.Marshal(Encode(uuid, FormatCanonical))
+}
+
+// UnmarshalJSON implements json.Unmarshaler. JSON null is unmarshalled as a
+// null value. Otherwise, the data must be a JSON string, which is unmarshalled
+// as UnmarshalText does, except an empty string is not a valid UUID.
+func (u *UUIDValue) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, jsonNull) {
+		u.StringValue = basetypes.NewStringNull()
+
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return fmt.Errorf("uuidtypes: cannot unmarshal UUID value: %w", err)
+	}
+
+	if value == "" {
+		return errors.New("uuidtypes: cannot unmarshal UUID value: empty string")
+	}
+
+	return u.UnmarshalText([]byte(value))
+}
+
+// marshalUUID returns the 16 byte representation of the value to be
+// marshalled. Values are parsed in any of the formats in FormatAll, or the
+// formats accepted by the value's UUIDType. A null value returns a zero value,
+// while an unknown value returns ErrUnknownValue.
+func (u UUIDValue) marshalUUID() ([16]byte, error) {
+	switch {
+	case u.IsUnknown():
+		return [16]byte{}, ErrUnknownValue
+	case u.IsNull():
+		return [16]byte{}, nil
+	}
+
+	uuid, err := parseUUID(u.ValueString(), FormatAll|u.uuidType.Formats, false)
+	if err != nil {
+		return [16]byte{}, fmt.Errorf("uuidtypes: cannot marshal UUID value: %w", err)
+	}
+
+	return uuid, nil
+}
@@ -0,0 +1,374 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidtypes_test
+
+import (
+	// Standard Library Imports
+	"encoding/json"
+	"errors"
+	"testing"
+
+	// External Imports
+	"github.com/google/go-cmp/cmp"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidtypes"
+)
+
+func TestUUIDValue_MarshalText(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		value       uuidtypes.UUIDValue
+		expected    string
+		expectedErr error
+	}{
+		{
+			name:     "null",
+			value:    uuidtypes.NewUUIDNull(),
+			expected: "",
+		},
+		{
+			name:        "unknown",
+			value:       uuidtypes.NewUUIDUnknown(),
+			expectedErr: uuidtypes.ErrUnknownValue,
+		},
+		{
+			name:     "value",
+			value:    uuidtypes.NewUUIDValue(valueUUIDv4),
+			expected: valueUUIDv4,
+		},
+		{
+			name:     "value-braced-uppercase",
+			value:    uuidtypes.NewUUIDValue("{" + valueUUIDv4Upper + "}"),
+			expected: valueUUIDv4,
+		},
+		{
+			name:     "value-base64url-accepted",
+			value:    uuidValueFromType(uuidtypes.UUIDType{Formats: uuidtypes.FormatBase64URL}, valueUUIDv4Base64URL),
+			expected: valueUUIDv4,
+		},
+		{
+			name:        "value-invalid",
+			value:       uuidtypes.NewUUIDValue(valueInvalid),
+			expectedErr: &uuidtypes.ParseError{},
+		},
+	}
+
+	for _, testcase := range tests {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testcase.value.MarshalText()
+			if !isExpectedErr(err, testcase.expectedErr) {
+				t.Fatalf("MarshalText()\nerror   : %v\nexpected: %v", err, testcase.expectedErr)
+			}
+
+			if err == nil && string(got) != testcase.expected {
+				t.Errorf("MarshalText()\ngot     : %q\nexpected: %q\n", got, testcase.expected)
+			}
+		})
+	}
+}
+
+func TestUUIDValue_UnmarshalText(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		text        string
+		expected    uuidtypes.UUIDValue
+		expectedErr bool
+	}{
+		{
+			name:     "empty",
+			text:     "",
+			expected: uuidtypes.NewUUIDNull(),
+		},
+		{
+			name:     "canonical",
+			text:     valueUUIDv4,
+			expected: uuidtypes.NewUUIDValue(valueUUIDv4),
+		},
+		{
+			name:     "urn-uppercase",
+			text:     "urn:uuid:" + valueUUIDv4Upper,
+			expected: uuidtypes.NewUUIDValue(valueUUIDv4),
+		},
+		{
+			name:        "base64url-not-accepted",
+			text:        valueUUIDv4Base64URL,
+			expectedErr: true,
+		},
+		{
+			name:        "invalid",
+			text:        valueInvalid,
+			expectedErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got uuidtypes.UUIDValue
+			err := got.UnmarshalText([]byte(testcase.text))
+			if (err != nil) != testcase.expectedErr {
+				t.Fatalf("UnmarshalText()\nerror   : %v\nexpected error: %v", err, testcase.expectedErr)
+			}
+
+			if err == nil {
+				if diff := cmp.Diff(got, testcase.expected); diff != "" {
+					t.Errorf("UnmarshalText()\ngot     : %v\nexpected: %v\ndiff    : %s\n", got, testcase.expected, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestUUIDValue_MarshalBinary(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		value       uuidtypes.UUIDValue
+		expected    []byte
+		expectedErr error
+	}{
+		{
+			name:     "null",
+			value:    uuidtypes.NewUUIDNull(),
+			expected: []byte{},
+		},
+		{
+			name:        "unknown",
+			value:       uuidtypes.NewUUIDUnknown(),
+			expectedErr: uuidtypes.ErrUnknownValue,
+		},
+		{
+			name:     "value",
+			value:    uuidtypes.NewUUIDValue(valueUUIDv4),
+			expected: bytesUUIDv4[:],
+		},
+		{
+			name:        "value-invalid",
+			value:       uuidtypes.NewUUIDValue(valueInvalid),
+			expectedErr: &uuidtypes.ParseError{},
+		},
+	}
+
+	for _, testcase := range tests {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testcase.value.MarshalBinary()
+			if !isExpectedErr(err, testcase.expectedErr) {
+				t.Fatalf("MarshalBinary()\nerror   : %v\nexpected: %v", err, testcase.expectedErr)
+			}
+
+			if diff := cmp.Diff(got, testcase.expected); err == nil && diff != "" {
+				t.Errorf("MarshalBinary()\ngot     : %x\nexpected: %x\ndiff    : %s\n", got, testcase.expected, diff)
+			}
+		})
+	}
+}
+
+func TestUUIDValue_UnmarshalBinary(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		data        []byte
+		expected    uuidtypes.UUIDValue
+		expectedErr bool
+	}{
+		{
+			name:     "empty",
+			data:     []byte{},
+			expected: uuidtypes.NewUUIDNull(),
+		},
+		{
+			name:     "value",
+			data:     bytesUUIDv4[:],
+			expected: uuidtypes.NewUUIDValue(valueUUIDv4),
+		},
+		{
+			name:        "too-short",
+			data:        bytesUUIDv4[:15],
+			expectedErr: true,
+		},
+		{
+			name:        "too-long",
+			data:        append(bytesUUIDv4[:], 0x00),
+			expectedErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got uuidtypes.UUIDValue
+			err := got.UnmarshalBinary(testcase.data)
+			if (err != nil) != testcase.expectedErr {
+				t.Fatalf("UnmarshalBinary()\nerror   : %v\nexpected error: %v", err, testcase.expectedErr)
+			}
+
+			if err == nil {
+				if diff := cmp.Diff(got, testcase.expected); diff != "" {
+					t.Errorf("UnmarshalBinary()\ngot     : %v\nexpected: %v\ndiff    : %s\n", got, testcase.expected, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestUUIDValue_MarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	type model struct {
+		ID       uuidtypes.UUIDValue   `json:"id"`
+		ParentID uuidtypes.UUIDValue   `json:"parent_id"`
+		Version4 uuidtypes.UUIDv4Value `json:"v4"`
+	}
+
+	tests := []struct {
+		name        string
+		value       model
+		expected    string
+		expectedErr error
+	}{
+		{
+			name: "values",
+			value: model{
+				ID:       uuidtypes.NewUUIDValue(valueUUIDv4Upper),
+				ParentID: uuidtypes.NewUUIDNull(),
+				Version4: uuidtypes.NewUUIDv4Value(valueUUIDv4),
+			},
+			expected: `{"id":"eb6f148a-6637-4c6b-a4bb-b75b2a1b5a3c","parent_id":null,"v4":"eb6f148a-6637-4c6b-a4bb-b75b2a1b5a3c"}`,
+		},
+		{
+			name: "unknown",
+			value: model{
+				ID:       uuidtypes.NewUUIDUnknown(),
+				ParentID: uuidtypes.NewUUIDNull(),
+				Version4: uuidtypes.NewUUIDv4Null(),
+			},
+			expectedErr: uuidtypes.ErrUnknownValue,
+		},
+		{
+			name: "invalid",
+			value: model{
+				ID:       uuidtypes.NewUUIDValue(valueInvalid),
+				ParentID: uuidtypes.NewUUIDNull(),
+				Version4: uuidtypes.NewUUIDv4Null(),
+			},
+			expectedErr: &uuidtypes.ParseError{},
+		},
+	}
+
+	for _, testcase := range tests {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := json.Marshal(testcase.value)
+			if !isExpectedErr(err, testcase.expectedErr) {
+				t.Fatalf("MarshalJSON()\nerror   : %v\nexpected: %v", err, testcase.expectedErr)
+			}
+
+			if err == nil && string(got) != testcase.expected {
+				t.Errorf("MarshalJSON()\ngot     : %s\nexpected: %s\n", got, testcase.expected)
+			}
+		})
+	}
+}
+
+func TestUUIDValue_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		data        string
+		expected    uuidtypes.UUIDValue
+		expectedErr bool
+	}{
+		{
+			name:     "null",
+			data:     `null`,
+			expected: uuidtypes.NewUUIDNull(),
+		},
+		{
+			name:     "value",
+			data:     `"` + valueUUIDv4 + `"`,
+			expected: uuidtypes.NewUUIDValue(valueUUIDv4),
+		},
+		{
+			name:     "value-braced",
+			data:     `"` + valueUUIDv4Braced + `"`,
+			expected: uuidtypes.NewUUIDValue(valueUUIDv4),
+		},
+		{
+			name:        "empty-string",
+			data:        `""`,
+			expectedErr: true,
+		},
+		{
+			name:        "not-a-string",
+			data:        `42`,
+			expectedErr: true,
+		},
+		{
+			name:        "invalid",
+			data:        `"` + valueInvalid + `"`,
+			expectedErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got uuidtypes.UUIDValue
+			err := json.Unmarshal([]byte(testcase.data), &got)
+			if (err != nil) != testcase.expectedErr {
+				t.Fatalf("UnmarshalJSON()\nerror   : %v\nexpected error: %v", err, testcase.expectedErr)
+			}
+
+			if err == nil {
+				if diff := cmp.Diff(got, testcase.expected); diff != "" {
+					t.Errorf("UnmarshalJSON()\ngot     : %v\nexpected: %v\ndiff    : %s\n", got, testcase.expected, diff)
+				}
+			}
+		})
+	}
+}
+
+// isExpectedErr returns true if err matches the expected error. A
+// *uuidtypes.ParseError expectation matches any wrapped ParseError.
+func isExpectedErr(err, expected error) bool {
+	var parseErr *uuidtypes.ParseError
+	if errors.As(expected, &parseErr) {
+		return errors.As(err, &parseErr)
+	}
+
+	return errors.Is(err, expected)
+}
@@ -0,0 +1,392 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidtypes
+
+import (
+	// Standard Library Imports
+	"encoding/base32"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// urnPrefix is the URN namespace prefix a UUID can be represented with.
+const urnPrefix = "urn:uuid:"
+
+// canonicalHexOffsets are the offsets of each pair of hex digits, or octet, in
+// the canonical hyphenated 8-4-4-4-12 format.
+var canonicalHexOffsets = [16]int{0, 2, 4, 6, 9, 11, 14, 16, 19, 21, 24, 26, 28, 30, 32, 34}
+
+// canonicalHyphenOffsets are the offsets of each hyphen in the canonical
+// hyphenated 8-4-4-4-12 format.
+var canonicalHyphenOffsets = [4]int{8, 13, 18, 23}
+
+// Parse parses a UUID string in the canonical hyphenated format, as defined
+// in RFC 9562, into its 16 byte representation. Hex digits are
+// case-insensitive. The variant and version bits are not inspected, use
+// ParseStrict to ensure the UUID conforms to RFC 9562.
+func Parse(value string) ([16]byte, error) {
+	return parseUUID(value, FormatCanonical, false)
+}
+
+// ParseStrict parses a UUID string in the canonical hyphenated format into
+// its 16 byte representation, ensuring the UUID conforms to RFC 9562. That
+// is, the UUID is either the Nil UUID, the Max UUID, or uses the RFC 9562
+// variant with a version defined by RFC 9562.
+func ParseStrict(value string) ([16]byte, error) {
+	return parseUUID(value, FormatCanonical, true)
+}
+
+// ParseFormat parses a UUID string in any of the given formats into its 16
+// byte representation. If no format is given, only the canonical hyphenated
+// format is accepted. Hex digits are case-insensitive.
+func ParseFormat(value string, formats Format) ([16]byte, error) {
+	return parseUUID(value, formats, false)
+}
+
+// parseUUID parses a UUID string in any of the given formats into its 16 byte
+// representation. If no format is given, only the canonical hyphenated format
+// is accepted. Hex digits are case-insensitive. If strict, the UUID must
+// conform to RFC 9562.
+func parseUUID(value string, formats Format, strict bool) ([16]byte, error) {
+	out, _, err := parseUUIDFormat(value, formats, strict)
+
+	return out, err
+}
+
+// parseUUIDFormat parses a UUID string as parseUUID does, additionally
+// returning the format the value was found to be in.
+func parseUUIDFormat(value string, formats Format, strict bool) ([16]byte, Format, error) {
+	formats = formats.orDefault()
+
+	// offset tracks where s starts within value, so errors are reported
+	// relative to the provided value.
+	s, offset := value, 0
+	format := FormatCanonical
+	switch {
+	case formats&FormatURN != 0 && hasURNPrefix(s):
+		s, offset, format = s[len(urnPrefix):], len(urnPrefix), FormatURN
+	case formats&FormatBraced != 0 && len(s) > 2 && s[0] == '{' && s[len(s)-1] == '}':
+		s, offset, format = s[1:len(s)-1], 1, FormatBraced
+	case formats&FormatHex != 0 && len(s) == 32:
+		format = FormatHex
+	case formats&FormatBase64URL != 0 && len(s) == 22:
+		format = FormatBase64URL
+	case formats&FormatBase32 != 0 && len(s) == 26:
+		format = FormatBase32
+	case formats&FormatCanonical == 0:
+		return [16]byte{}, 0, newParseError(value, ParseErrorFormat, 0, fmt.Sprintf("expected %s", formats))
+	}
+
+	var out [16]byte
+	var err *syntaxError
+	switch format {
+	case FormatHex:
+		out, err = decodeHexString(s)
+	case FormatBase64URL:
+		out, err = decodeEncoded(s, base64URLEncoding, false)
+	case FormatBase32:
+		out, err = decodeEncoded(s, base32Encoding, true)
+	default:
+		out, err = decodeCanonical(s)
+	}
+
+	if err == nil && strict {
+		err = conformsToRFC9562(out, format)
+	}
+
+	if err != nil {
+		return [16]byte{}, 0, newParseError(value, err.kind, offset+max(err.offset, 0), err.msg(offset))
+	}
+
+	return out, format, nil
+}
+
+// syntaxError describes a parse failure relative to the string being decoded.
+type syntaxError struct {
+	kind   ParseErrorKind
+	offset int
+	detail string
+}
+
+// msg returns the description of the failure, including the offset adjusted
+// by base where the failure is at a specific character. A negative offset
+// denotes a failure that is not at a specific character.
+func (e *syntaxError) msg(base int) string {
+	if e.kind == ParseErrorWrongLength || e.offset < 0 {
+		return e.detail
+	}
+
+	return fmt.Sprintf("%s at offset %d", e.detail, base+e.offset)
+}
+
+// decodeCanonical decodes a UUID in the canonical hyphenated 8-4-4-4-12
+// format.
+func decodeCanonical(s string) ([16]byte, *syntaxError) {
+	var out [16]byte
+	if len(s) != 36 {
+		return out, lengthError(s, 36)
+	}
+
+	for i := 0; i < len(s); i++ {
+		if slices.Contains(canonicalHyphenOffsets[:], i) {
+			if s[i] != '-' {
+				return out, characterError(s[i], i, true)
+			}
+
+			continue
+		}
+
+		if _, ok := fromHexChar(s[i]); !ok {
+			return out, characterError(s[i], i, false)
+		}
+	}
+
+	for i, offset := range canonicalHexOffsets {
+		out[i], _ = decodeHexPair(s[offset], s[offset+1])
+	}
+
+	return out, nil
+}
+
+// decodeHexString decodes a UUID in the 32 hex digit format.
+func decodeHexString(s string) ([16]byte, *syntaxError) {
+	var out [16]byte
+	if len(s) != 32 {
+		return out, lengthError(s, 32)
+	}
+
+	for i := 0; i < len(s); i++ {
+		if _, ok := fromHexChar(s[i]); !ok {
+			return out, characterError(s[i], i, false)
+		}
+	}
+
+	out, _ = decodeHex(s)
+
+	return out, nil
+}
+
+// textEncoding is a binary-to-text encoding, such as base64.Encoding or
+// base32.Encoding.
+type textEncoding interface {
+	Decode(dst, src []byte) (int, error)
+	EncodeToString(src []byte) string
+}
+
+// decodeEncoded decodes a UUID in a binary-to-text encoding, ignoring letter
+// case if the encoding's alphabet is uppercase. The string must decode to
+// exactly 16 bytes, and be the encoding's only representation of those bytes,
+// so the unused bits of the final character must be zero.
+func decodeEncoded(s string, encoding textEncoding, ignoreCase bool) ([16]byte, *syntaxError) {
+	var out [16]byte
+	if i := strings.IndexFunc(s, unicode.IsSpace); i >= 0 {
+		return out, &syntaxError{kind: ParseErrorWhitespace, offset: i, detail: "found whitespace"}
+	}
+
+	src := s
+	if ignoreCase {
+		src = upperASCII(s)
+	}
+
+	n, err := encoding.Decode(out[:], []byte(src))
+	if err != nil || n != len(out) || encoding.EncodeToString(out[:]) != src {
+		offset := len(s) - 1
+		var base64Err base64.CorruptInputError
+		var base32Err base32.CorruptInputError
+		switch {
+		case errors.As(err, &base64Err):
+			offset = min(int(base64Err), offset)
+		case errors.As(err, &base32Err):
+			offset = min(int(base32Err), offset)
+		}
+
+		return [16]byte{}, &syntaxError{kind: ParseErrorInvalidEncoding, offset: offset, detail: fmt.Sprintf("found %q", s[offset])}
+	}
+
+	return out, nil
+}
+
+// upperASCII returns the string with ASCII lowercase letters converted to
+// uppercase, retaining the byte offset of every character.
+func upperASCII(s string) string {
+	b := []byte(s)
+	for i, c := range b {
+		if 'a' <= c && c <= 'z' {
+			b[i] = c - 'a' + 'A'
+		}
+	}
+
+	return string(b)
+}
+
+// lengthError returns the syntax error for a string of the wrong length. If
+// the string contains whitespace, braces or a URN prefix, these are reported
+// instead as the more likely cause.
+func lengthError(s string, expected int) *syntaxError {
+	if i := strings.IndexFunc(s, unicode.IsSpace); i >= 0 {
+		return &syntaxError{kind: ParseErrorWhitespace, offset: i, detail: "found whitespace"}
+	}
+
+	if i := strings.IndexAny(s, "{}"); i >= 0 {
+		return &syntaxError{kind: ParseErrorBraces, offset: i, detail: fmt.Sprintf("found %q", s[i])}
+	}
+
+	if hasURNPrefix(s) {
+		return &syntaxError{kind: ParseErrorFormat, offset: 0, detail: "found a URN prefix"}
+	}
+
+	offset := len(s)
+	if offset > expected {
+		offset = expected
+	}
+
+	return &syntaxError{
+		kind:   ParseErrorWrongLength,
+		offset: offset,
+		detail: fmt.Sprintf("expected %d characters but got %d", expected, len(s)),
+	}
+}
+
+// characterError returns the syntax error for an unexpected character found
+// at the offset, where either a hyphen or a hex digit was expected.
+func characterError(c byte, offset int, expectHyphen bool) *syntaxError {
+	_, isHex := fromHexChar(c)
+
+	switch {
+	case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+		return &syntaxError{kind: ParseErrorWhitespace, offset: offset, detail: "found whitespace"}
+	case c == '{' || c == '}':
+		return &syntaxError{kind: ParseErrorBraces, offset: offset, detail: fmt.Sprintf("found %q", c)}
+	case expectHyphen && isHex:
+		return &syntaxError{kind: ParseErrorMisplacedHyphen, offset: offset, detail: fmt.Sprintf("expected '-' but found %q", c)}
+	case c == '-':
+		return &syntaxError{kind: ParseErrorMisplacedHyphen, offset: offset, detail: "expected a hex digit but found '-'"}
+	default:
+		return &syntaxError{kind: ParseErrorInvalidHex, offset: offset, detail: fmt.Sprintf("found %q", c)}
+	}
+}
+
+// decodeHex decodes a string of exactly 32 hex digits.
+func decodeHex(s string) ([16]byte, bool) {
+	var out [16]byte
+	if len(s) != 32 {
+		return out, false
+	}
+
+	for i := range out {
+		b, ok := decodeHexPair(s[i*2], s[i*2+1])
+		if !ok {
+			return [16]byte{}, false
+		}
+
+		out[i] = b
+	}
+
+	return out, true
+}
+
+// decodeHexPair decodes a pair of case-insensitive hex digits into a byte.
+func decodeHexPair(hi, lo byte) (byte, bool) {
+	h, ok := fromHexChar(hi)
+	if !ok {
+		return 0, false
+	}
+
+	l, ok := fromHexChar(lo)
+	if !ok {
+		return 0, false
+	}
+
+	return h<<4 | l, true
+}
+
+// fromHexChar converts a case-insensitive hex digit into its value.
+func fromHexChar(c byte) (byte, bool) {
+	switch {
+	case '0' <= c && c <= '9':
+		return c - '0', true
+	case 'a' <= c && c <= 'f':
+		return c - 'a' + 10, true
+	case 'A' <= c && c <= 'F':
+		return c - 'A' + 10, true
+	}
+
+	return 0, false
+}
+
+// canonicalString returns the UUID in the canonical lowercase hyphenated
+// format.
+func canonicalString(uuid [16]byte) string {
+	const hexDigits = "0123456789abcdef"
+
+	out := []byte("00000000-0000-0000-0000-000000000000")
+	for i, offset := range canonicalHexOffsets {
+		out[offset] = hexDigits[uuid[i]>>4]
+		out[offset+1] = hexDigits[uuid[i]&0x0f]
+	}
+
+	return string(out)
+}
+
+// conformsToRFC9562 returns a syntax error describing why the UUID does not
+// conform to RFC 9562, or nil if it does. For formats using hex digits, the
+// error offset points to the hex digit holding the offending bits.
+func conformsToRFC9562(uuid [16]byte, format Format) *syntaxError {
+	if uuid == NilUUID || uuid == MaxUUID {
+		return nil
+	}
+
+	var variantOffset, versionOffset int
+	switch format {
+	case FormatHex:
+		variantOffset, versionOffset = 16, 12
+	case FormatBase64URL, FormatBase32:
+		variantOffset, versionOffset = -1, -1
+	default:
+		variantOffset, versionOffset = canonicalHexOffsets[8], canonicalHexOffsets[6]
+	}
+
+	if variant := VariantOf(uuid); variant != VariantRFC9562 {
+		return &syntaxError{
+			kind:   ParseErrorVariant,
+			offset: variantOffset,
+			detail: fmt.Sprintf("expected the %s variant but found the %s variant", VariantRFC9562, variant),
+		}
+	}
+
+	if version := VersionOf(uuid); version < Version1 || version > Version8 {
+		return &syntaxError{
+			kind:   ParseErrorVersion,
+			offset: versionOffset,
+			detail: fmt.Sprintf("expected a version defined by RFC 9562 but found version %d", version),
+		}
+	}
+
+	return nil
+}
+
+// hasURNPrefix returns true if the value starts with the case-insensitive
+// "urn:uuid:" prefix.
+func hasURNPrefix(value string) bool {
+	return len(value) > len(urnPrefix) && strings.EqualFold(value[:len(urnPrefix)], urnPrefix)
+}
+
+// hasUppercaseHex returns true if the value contains an uppercase hex digit.
+// A URN prefix is ignored as URN namespaces are case-insensitive.
+func hasUppercaseHex(value string) bool {
+	if hasURNPrefix(value) {
+		value = value[len(urnPrefix):]
+	}
+
+	return strings.ContainsAny(value, "ABCDEF")
+}
@@ -0,0 +1,183 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidtypes
+
+import (
+	// Standard Library Imports
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ParseErrorKind categorises why a string failed to parse as a UUID.
+type ParseErrorKind uint8
+
+const (
+	// ParseErrorWrongLength is returned when the string is not the length
+	// expected for the format.
+	ParseErrorWrongLength ParseErrorKind = iota + 1
+	// ParseErrorInvalidHex is returned when a character that is not a hex
+	// digit is found where a hex digit was expected.
+	ParseErrorInvalidHex
+	// ParseErrorMisplacedHyphen is returned when a hyphen is missing or found
+	// where a hex digit was expected.
+	ParseErrorMisplacedHyphen
+	// ParseErrorWhitespace is returned when the string contains whitespace.
+	ParseErrorWhitespace
+	// ParseErrorBraces is returned when the string contains braces, but the
+	// braced format is not accepted or the braces are unbalanced.
+	ParseErrorBraces
+	// ParseErrorFormat is returned when the string is in a format that is not
+	// accepted.
+	ParseErrorFormat
+	// ParseErrorVariant is returned in strict mode when the UUID does not use
+	// the RFC 9562 variant.
+	ParseErrorVariant
+	// ParseErrorVersion is returned in strict mode when the UUID version is
+	// not defined by RFC 9562.
+	ParseErrorVersion
+	// ParseErrorInvalidEncoding is returned when a character that is not part
+	// of the base64url or base32 alphabet is found in an encoded UUID, or the
+	// final character holds bits beyond the 16 bytes of the UUID.
+	ParseErrorInvalidEncoding
+)
+
+// String returns a human-friendly name of the parse error kind.
+func (k ParseErrorKind) String() string {
+	switch k {
+	case ParseErrorWrongLength:
+		return "wrong length"
+	case ParseErrorInvalidHex:
+		return "invalid hex character"
+	case ParseErrorMisplacedHyphen:
+		return "misplaced hyphen"
+	case ParseErrorWhitespace:
+		return "stray whitespace"
+	case ParseErrorBraces:
+		return "unexpected braces"
+	case ParseErrorFormat:
+		return "unaccepted format"
+	case ParseErrorVariant:
+		return "invalid variant"
+	case ParseErrorVersion:
+		return "invalid version"
+	case ParseErrorInvalidEncoding:
+		return "invalid encoded character"
+	default:
+		return fmt.Sprintf("ParseErrorKind(%d)", uint8(k))
+	}
+}
+
+// ParseError describes why a string failed to parse as a UUID.
+type ParseError struct {
+	// Value is the string that failed to parse.
+	Value string
+
+	// Kind categorises the failure.
+	Kind ParseErrorKind
+
+	// Offset is the byte offset into Value at which parsing failed.
+	Offset int
+
+	// Msg describes the failure.
+	Msg string
+
+	// Suggestion is the corrected value in the canonical format, if the
+	// correction is unambiguous. Otherwise, it is empty.
+	Suggestion string
+}
+
+// Error returns the description of the parse failure.
+func (e *ParseError) Error() string {
+	return e.Kind.String() + ": " + e.Msg
+}
+
+// newParseError returns a ParseError for the value, suggesting a correction
+// for syntactic failures where the correction is unambiguous.
+func newParseError(value string, kind ParseErrorKind, offset int, msg string) *ParseError {
+	err := &ParseError{
+		Value:  value,
+		Kind:   kind,
+		Offset: offset,
+		Msg:    msg,
+	}
+
+	if kind != ParseErrorVariant && kind != ParseErrorVersion {
+		err.Suggestion = suggestCanonical(value)
+	}
+
+	return err
+}
+
+// suggestCanonical returns the value in the canonical format if stripping
+// surrounding whitespace, a URN prefix, braces and hyphens leaves exactly 32
+// hex digits. Otherwise, an empty string is returned.
+func suggestCanonical(value string) string {
+	s := strings.TrimSpace(value)
+	if hasURNPrefix(s) {
+		s = s[len(urnPrefix):]
+	}
+
+	s = strings.TrimPrefix(s, "{")
+	s = strings.TrimSuffix(s, "}")
+	s = strings.ReplaceAll(s, "-", "")
+
+	uuid, ok := decodeHex(s)
+	if !ok {
+		return ""
+	}
+
+	return canonicalString(uuid)
+}
+
+// invalidUUIDStringDetail returns the diagnostic detail reported when a string
+// value fails to parse as a UUID. Where the failure is a ParseError, the
+// offset of the failure is pointed out and a correction suggested if
+// available.
+func invalidUUIDStringDetail(value string, err error) string {
+	detail := "An unexpected error occurred attempting to parse a string value that was expected to be a valid UUID format. " +
+		"The expected UUID format is 00000000-0000-0000-0000-000000000000. " +
+		"For example, a Version 4 UUID is of the form 7b16fd41-cc23-4ef7-8aa9-c598350ccd18.\n\n" +
+		fmt.Sprintf("Provided Value: %q\n", value) +
+		fmt.Sprintf("Parse Error: %s", err.Error())
+
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) {
+		return detail
+	}
+
+	if pointer := offsetPointer(parseErr.Value, parseErr.Offset); pointer != "" {
+		detail += "\n\n" + pointer
+	}
+
+	if parseErr.Suggestion != "" {
+		detail += fmt.Sprintf("\n\nSuggested Value: %q", parseErr.Suggestion)
+	}
+
+	return detail
+}
+
+// offsetPointer returns the value with a caret on the following line pointing
+// at the offset. As the caret is aligned by character count, an empty string
+// is returned if the value contains anything other than printable ASCII
+// characters.
+func offsetPointer(value string, offset int) string {
+	if value == "" || offset < 0 || offset > len(value) {
+		return ""
+	}
+
+	for i := 0; i < len(value); i++ {
+		if value[i] < ' ' || value[i] > '~' {
+			return ""
+		}
+	}
+
+	return "    " + value + "\n" +
+		"    " + strings.Repeat(" ", offset) + "^"
+}
@@ -0,0 +1,478 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidtypes_test
+
+import (
+	// Standard Library Imports
+	"errors"
+	"testing"
+
+	// External Imports
+	"github.com/google/go-cmp/cmp"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidtypes"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		value    string
+		strict   bool
+		expected [16]byte
+		// expectedErr is the expected ParseError, if any.
+		expectedErr *uuidtypes.ParseError
+	}{
+		{
+			name:  "empty",
+			value: "",
+			expectedErr: &uuidtypes.ParseError{
+				Kind:   uuidtypes.ParseErrorWrongLength,
+				Offset: 0,
+				Msg:    "expected 36 characters but got 0",
+			},
+		},
+		{
+			name:  "invalid-length",
+			value: valueInvalidLength,
+			expectedErr: &uuidtypes.ParseError{
+				Kind:   uuidtypes.ParseErrorWrongLength,
+				Offset: 17,
+				Msg:    "expected 36 characters but got 17",
+			},
+		},
+		{
+			name:  "invalid-length-too-long",
+			value: valueUUIDv4 + "0",
+			expectedErr: &uuidtypes.ParseError{
+				Kind:   uuidtypes.ParseErrorWrongLength,
+				Offset: 36,
+				Msg:    "expected 36 characters but got 37",
+			},
+		},
+		{
+			name:  "invalid-format",
+			value: valueInvalid,
+			expectedErr: &uuidtypes.ParseError{
+				Kind:   uuidtypes.ParseErrorInvalidHex,
+				Offset: 2,
+				Msg:    "found 't' at offset 2",
+			},
+		},
+		{
+			name:  "invalid-hex",
+			value: "eb6f148a-6637-4c6b-a4bb-b75b2a1b5a3g",
+			expectedErr: &uuidtypes.ParseError{
+				Kind:   uuidtypes.ParseErrorInvalidHex,
+				Offset: 35,
+				Msg:    "found 'g' at offset 35",
+			},
+		},
+		{
+			name:  "hyphen-misplaced",
+			value: "eb6f148a6-637-4c6b-a4bb-b75b2a1b5a3c",
+			expectedErr: &uuidtypes.ParseError{
+				Kind:       uuidtypes.ParseErrorMisplacedHyphen,
+				Offset:     8,
+				Msg:        "expected '-' but found '6' at offset 8",
+				Suggestion: valueUUIDv4,
+			},
+		},
+		{
+			name:  "hyphen-unexpected",
+			value: "eb6f148-a6637-4c6b-a4bb-b75b2a1b5a3c",
+			expectedErr: &uuidtypes.ParseError{
+				Kind:       uuidtypes.ParseErrorMisplacedHyphen,
+				Offset:     7,
+				Msg:        "expected a hex digit but found '-' at offset 7",
+				Suggestion: valueUUIDv4,
+			},
+		},
+		{
+			name:  "hyphen-missing",
+			value: "eb6f148a66374c6b-a4bb-b75b2a1b5a3c",
+			expectedErr: &uuidtypes.ParseError{
+				Kind:       uuidtypes.ParseErrorWrongLength,
+				Offset:     34,
+				Msg:        "expected 36 characters but got 34",
+				Suggestion: valueUUIDv4,
+			},
+		},
+		{
+			name:  "whitespace-leading",
+			value: " " + valueUUIDv4,
+			expectedErr: &uuidtypes.ParseError{
+				Kind:       uuidtypes.ParseErrorWhitespace,
+				Offset:     0,
+				Msg:        "found whitespace at offset 0",
+				Suggestion: valueUUIDv4,
+			},
+		},
+		{
+			name:  "whitespace-interior",
+			value: "eb6f148a-6637-4c6b a4bb-b75b2a1b5a3c",
+			expectedErr: &uuidtypes.ParseError{
+				Kind:   uuidtypes.ParseErrorWhitespace,
+				Offset: 18,
+				Msg:    "found whitespace at offset 18",
+			},
+		},
+		{
+			name:  "braced-not-accepted",
+			value: valueUUIDv4Braced,
+			expectedErr: &uuidtypes.ParseError{
+				Kind:       uuidtypes.ParseErrorBraces,
+				Offset:     0,
+				Msg:        "found '{' at offset 0",
+				Suggestion: valueUUIDv4,
+			},
+		},
+		{
+			name:  "braced-unbalanced",
+			value: valueUUIDv4 + "}",
+			expectedErr: &uuidtypes.ParseError{
+				Kind:       uuidtypes.ParseErrorBraces,
+				Offset:     36,
+				Msg:        "found '}' at offset 36",
+				Suggestion: valueUUIDv4,
+			},
+		},
+		{
+			name:  "urn-not-accepted",
+			value: valueUUIDv4URN,
+			expectedErr: &uuidtypes.ParseError{
+				Kind:       uuidtypes.ParseErrorFormat,
+				Offset:     0,
+				Msg:        "found a URN prefix at offset 0",
+				Suggestion: valueUUIDv4,
+			},
+		},
+		{
+			name:  "hex-not-accepted",
+			value: valueUUIDv4Hex,
+			expectedErr: &uuidtypes.ParseError{
+				Kind:       uuidtypes.ParseErrorWrongLength,
+				Offset:     32,
+				Msg:        "expected 36 characters but got 32",
+				Suggestion: valueUUIDv4,
+			},
+		},
+		{
+			name:     "lowercase",
+			value:    valueUUIDv4,
+			expected: bytesUUIDv4,
+		},
+		{
+			name:     "uppercase",
+			value:    valueUUIDv4Upper,
+			expected: bytesUUIDv4,
+		},
+		{
+			name:     "variant-microsoft",
+			value:    valueUUIDv4VariantMicrosoft,
+			expected: [16]byte{0xeb, 0x6f, 0x14, 0x8a, 0x66, 0x37, 0x4c, 0x6b, 0xc4, 0xbb, 0xb7, 0x5b, 0x2a, 0x1b, 0x5a, 0x3c},
+		},
+		{
+			name:     "version-0",
+			value:    "eb6f148a-6637-0c6b-a4bb-b75b2a1b5a3c",
+			expected: [16]byte{0xeb, 0x6f, 0x14, 0x8a, 0x66, 0x37, 0x0c, 0x6b, 0xa4, 0xbb, 0xb7, 0x5b, 0x2a, 0x1b, 0x5a, 0x3c},
+		},
+		{
+			name:     "strict-valid",
+			value:    valueUUIDv4Upper,
+			strict:   true,
+			expected: bytesUUIDv4,
+		},
+		{
+			name:     "strict-nil",
+			value:    valueUUIDNil,
+			strict:   true,
+			expected: uuidtypes.NilUUID,
+		},
+		{
+			name:     "strict-max",
+			value:    valueUUIDMax,
+			strict:   true,
+			expected: uuidtypes.MaxUUID,
+		},
+		{
+			name:   "strict-variant-microsoft",
+			value:  valueUUIDv4VariantMicrosoft,
+			strict: true,
+			expectedErr: &uuidtypes.ParseError{
+				Kind:   uuidtypes.ParseErrorVariant,
+				Offset: 19,
+				Msg:    "expected the RFC 9562 variant but found the Microsoft variant at offset 19",
+			},
+		},
+		{
+			name:   "strict-version-0",
+			value:  "eb6f148a-6637-0c6b-a4bb-b75b2a1b5a3c",
+			strict: true,
+			expectedErr: &uuidtypes.ParseError{
+				Kind:   uuidtypes.ParseErrorVersion,
+				Offset: 14,
+				Msg:    "expected a version defined by RFC 9562 but found version 0 at offset 14",
+			},
+		},
+		{
+			name:   "strict-version-9",
+			value:  "eb6f148a-6637-9c6b-a4bb-b75b2a1b5a3c",
+			strict: true,
+			expectedErr: &uuidtypes.ParseError{
+				Kind:   uuidtypes.ParseErrorVersion,
+				Offset: 14,
+				Msg:    "expected a version defined by RFC 9562 but found version 9 at offset 14",
+			},
+		},
+		{
+			name:   "strict-invalid-format",
+			value:  valueInvalid,
+			strict: true,
+			expectedErr: &uuidtypes.ParseError{
+				Kind:   uuidtypes.ParseErrorInvalidHex,
+				Offset: 2,
+				Msg:    "found 't' at offset 2",
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			parse := uuidtypes.Parse
+			if testcase.strict {
+				parse = uuidtypes.ParseStrict
+			}
+
+			got, err := parse(testcase.value)
+			if testcase.expectedErr != nil {
+				var parseErr *uuidtypes.ParseError
+				if !errors.As(err, &parseErr) {
+					t.Fatalf("Parse()\nerror   : %v\nexpected: *uuidtypes.ParseError", err)
+				}
+
+				expectedErr := *testcase.expectedErr
+				expectedErr.Value = testcase.value
+				if diff := cmp.Diff(*parseErr, expectedErr); diff != "" {
+					t.Errorf("Parse()\nerror   : %+v\nexpected: %+v\ndiff    : %s", *parseErr, expectedErr, diff)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Parse() unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(got, testcase.expected); diff != "" {
+				t.Errorf("Parse()\ngot     : %v\nexpected: %v\ndiff    : %s\n", got, testcase.expected, diff)
+			}
+		})
+	}
+}
+
+func TestParseError_Error(t *testing.T) {
+	t.Parallel()
+
+	_, err := uuidtypes.Parse("eb6f148a6-637-4c6b-a4bb-b75b2a1b5a3c")
+
+	expected := "misplaced hyphen: expected '-' but found '6' at offset 8"
+	if err == nil || err.Error() != expected {
+		t.Errorf("Error()\ngot     : %v\nexpected: %s", err, expected)
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		value       string
+		formats     uuidtypes.Format
+		expectedErr bool
+	}{
+		{
+			name:  "zero-canonical",
+			value: valueUUIDv4,
+		},
+		{
+			name:        "zero-braced",
+			value:       valueUUIDv4Braced,
+			expectedErr: true,
+		},
+		{
+			name:    "all-canonical",
+			value:   valueUUIDv4Upper,
+			formats: uuidtypes.FormatAll,
+		},
+		{
+			name:    "all-braced",
+			value:   valueUUIDv4Braced,
+			formats: uuidtypes.FormatAll,
+		},
+		{
+			name:    "all-urn",
+			value:   valueUUIDv4URN,
+			formats: uuidtypes.FormatAll,
+		},
+		{
+			name:    "all-hex",
+			value:   valueUUIDv4Hex,
+			formats: uuidtypes.FormatAll,
+		},
+		{
+			name:        "hex-canonical",
+			value:       valueUUIDv4,
+			formats:     uuidtypes.FormatHex,
+			expectedErr: true,
+		},
+		{
+			name:        "all-base64url",
+			value:       valueUUIDv4Base64URL,
+			formats:     uuidtypes.FormatAll,
+			expectedErr: true,
+		},
+		{
+			name:    "base64url",
+			value:   valueUUIDv4Base64URL,
+			formats: uuidtypes.FormatBase64URL,
+		},
+		{
+			name:        "base64url-case-sensitive",
+			value:       "628uIMy3tgUKU7DBkHTApa",
+			formats:     uuidtypes.FormatBase64URL,
+			expectedErr: true,
+		},
+		{
+			name:    "base32",
+			value:   valueUUIDv4Base32,
+			formats: uuidtypes.FormatBase32,
+		},
+		{
+			name:    "base32-lowercase",
+			value:   "5nxrjctgg5ggxjf3w5nsug22hq",
+			formats: uuidtypes.FormatBase32,
+		},
+		{
+			name:    "encoded-canonical",
+			value:   valueUUIDv4,
+			formats: uuidtypes.FormatEncoded | uuidtypes.FormatCanonical,
+		},
+		{
+			name:    "encoded-base64url",
+			value:   valueUUIDv4Base64URL,
+			formats: uuidtypes.FormatEncoded,
+		},
+	}
+
+	for _, testcase := range tests {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := uuidtypes.ParseFormat(testcase.value, testcase.formats)
+			if (err != nil) != testcase.expectedErr {
+				t.Fatalf("ParseFormat()\nerror   : %v\nexpected error: %v", err, testcase.expectedErr)
+			}
+
+			if err == nil && got != bytesUUIDv4 {
+				t.Errorf("ParseFormat()\ngot     : %v\nexpected: %v", got, bytesUUIDv4)
+			}
+		})
+	}
+}
+
+func TestParseFormat_EncodedErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		value       string
+		formats     uuidtypes.Format
+		expectedErr uuidtypes.ParseError
+	}{
+		{
+			name:    "base64url-invalid-character",
+			value:   "628UimY3TGuku7db+htaPA",
+			formats: uuidtypes.FormatBase64URL,
+			expectedErr: uuidtypes.ParseError{
+				Kind:   uuidtypes.ParseErrorInvalidEncoding,
+				Offset: 16,
+				Msg:    "found '+' at offset 16",
+			},
+		},
+		{
+			name:    "base64url-trailing-bits",
+			value:   "628UimY3TGuku7dbKhtaPB",
+			formats: uuidtypes.FormatBase64URL,
+			expectedErr: uuidtypes.ParseError{
+				Kind:   uuidtypes.ParseErrorInvalidEncoding,
+				Offset: 21,
+				Msg:    "found 'B' at offset 21",
+			},
+		},
+		{
+			name:    "base64url-whitespace",
+			value:   "628UimY3TGuku7dbKht PA",
+			formats: uuidtypes.FormatBase64URL,
+			expectedErr: uuidtypes.ParseError{
+				Kind:   uuidtypes.ParseErrorWhitespace,
+				Offset: 19,
+				Msg:    "found whitespace at offset 19",
+			},
+		},
+		{
+			name:    "base32-invalid-character",
+			value:   "5NXRJCTGG5GGXJF3W5NSUG22H1",
+			formats: uuidtypes.FormatBase32,
+			expectedErr: uuidtypes.ParseError{
+				Kind:   uuidtypes.ParseErrorInvalidEncoding,
+				Offset: 25,
+				Msg:    "found '1' at offset 25",
+			},
+		},
+		{
+			name:    "encoded-wrong-length",
+			value:   "628UimY3TGuku7dbKhta",
+			formats: uuidtypes.FormatEncoded,
+			expectedErr: uuidtypes.ParseError{
+				Kind: uuidtypes.ParseErrorFormat,
+				Msg:  "expected base64url|base32",
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := uuidtypes.ParseFormat(testcase.value, testcase.formats)
+
+			var parseErr *uuidtypes.ParseError
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("ParseFormat()\nerror   : %v\nexpected: *uuidtypes.ParseError", err)
+			}
+
+			expectedErr := testcase.expectedErr
+			expectedErr.Value = testcase.value
+			if diff := cmp.Diff(*parseErr, expectedErr); diff != "" {
+				t.Errorf("ParseFormat()\nerror   : %+v\nexpected: %+v\ndiff    : %s", *parseErr, expectedErr, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidtypes
+
+import (
+	// Standard Library Imports
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+
+	// External Imports
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Ensure Implementation matches the expected interfaces.
+var (
+	_ sql.Scanner   = (*UUIDValue)(nil)
+	_ driver.Valuer = UUIDValue{}
+)
+
+// Scan implements sql.Scanner. SQL NULL is scanned as a null value. A 16 byte
+// []byte, as read from a binary column, is scanned as the RFC 9562 big-endian
+// representation of the UUID. Any other string or []byte, as read from a
+// textual column, is parsed in any of the formats in FormatAll, or the formats
+// accepted by the value's UUIDType. The UUID is stored in the canonical format.
+func (u *UUIDValue) Scan(src any) error {
+	switch src := src.(type) {
+	case nil:
+		u.StringValue = basetypes.NewStringNull()
+
+		return nil
+
+	case []byte:
+		if len(src) == 16 {
+			return u.UnmarshalBinary(src)
+		}
+
+		return u.scanText(string(src))
+
+	case string:
+		return u.scanText(src)
+
+	default:
+		return fmt.Errorf("uuidtypes: cannot scan %T into a UUID value", src)
+	}
+}
+
+// scanText scans a UUID from a textual column. Unlike UnmarshalText, an empty
+// string is not a null value.
+func (u *UUIDValue) scanText(src string) error {
+	if src == "" {
+		return errors.New("uuidtypes: cannot scan an empty string into a UUID value")
+	}
+
+	return u.UnmarshalText([]byte(src))
+}
+
+// Value implements driver.Valuer. A known value is written as a string in the
+// canonical lowercase hyphenated format, while a null value is written as SQL
+// NULL. Writing an unknown value returns ErrUnknownValue. To write to a binary
+// column, pass the result of MarshalBinary instead.
+func (u UUIDValue) Value() (driver.Value, error) {
+	uuid, err := u.marshalUUID()
+	if err != nil {
+		return nil, err
+	}
+
+	if u.IsNull() {
+		return nil, nil
+	}
+
+	return Encode(uuid, FormatCanonical), nil
+}
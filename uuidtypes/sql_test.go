@@ -0,0 +1,247 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidtypes_test
+
+import (
+	// Standard Library Imports
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"testing"
+
+	// External Imports
+	"github.com/google/go-cmp/cmp"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidtypes"
+)
+
+// echoDriverName is the name the in-memory echo driver is registered under.
+const echoDriverName = "uuidtypes-echo"
+
+func init() {
+	sql.Register(echoDriverName, echoDriver{})
+}
+
+// echoDriver is an in-memory database/sql driver. Every query returns a single
+// row containing the query's arguments as columns, after they have been
+// converted by database/sql, enabling values to round trip through
+// driver.Valuer and sql.Scanner.
+type echoDriver struct{}
+
+func (echoDriver) Open(string) (driver.Conn, error) { return echoConn{}, nil }
+
+type echoConn struct{}
+
+func (echoConn) Prepare(string) (driver.Stmt, error) { return echoStmt{}, nil }
+func (echoConn) Close() error                        { return nil }
+func (echoConn) Begin() (driver.Tx, error)           { return nil, errors.New("not supported") }
+
+type echoStmt struct{}
+
+func (echoStmt) Close() error  { return nil }
+func (echoStmt) NumInput() int { return -1 }
+func (echoStmt) Exec([]driver.Value) (driver.Result, error) {
+	return nil, errors.New("not supported")
+}
+func (echoStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return &echoRows{row: args}, nil
+}
+
+type echoRows struct {
+	row  []driver.Value
+	done bool
+}
+
+func (r *echoRows) Columns() []string {
+	columns := make([]string, len(r.row))
+	for i := range columns {
+		columns[i] = "column"
+	}
+
+	return columns
+}
+func (r *echoRows) Close() error { return nil }
+func (r *echoRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+
+	r.done = true
+	copy(dest, r.row)
+
+	return nil
+}
+
+func TestUUIDValue_Scan(t *testing.T) {
+	t.Parallel()
+
+	db, err := sql.Open(echoDriverName, "")
+	if err != nil {
+		t.Fatalf("sql.Open() unexpected error: %v", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	tests := []struct {
+		name        string
+		column      any
+		expected    uuidtypes.UUIDValue
+		expectedErr bool
+	}{
+		{
+			name:     "null",
+			column:   nil,
+			expected: uuidtypes.NewUUIDNull(),
+		},
+		{
+			name:     "text",
+			column:   valueUUIDv4,
+			expected: uuidtypes.NewUUIDValue(valueUUIDv4),
+		},
+		{
+			name:     "text-uppercase-braced",
+			column:   "{" + valueUUIDv4Upper + "}",
+			expected: uuidtypes.NewUUIDValue(valueUUIDv4),
+		},
+		{
+			name:     "text-bytes",
+			column:   []byte(valueUUIDv4Hex),
+			expected: uuidtypes.NewUUIDValue(valueUUIDv4),
+		},
+		{
+			name:     "binary",
+			column:   bytesUUIDv4[:],
+			expected: uuidtypes.NewUUIDValue(valueUUIDv4),
+		},
+		{
+			name:        "text-empty",
+			column:      "",
+			expectedErr: true,
+		},
+		{
+			name:        "text-invalid",
+			column:      valueInvalid,
+			expectedErr: true,
+		},
+		{
+			name:        "binary-too-short",
+			column:      bytesUUIDv4[:8],
+			expectedErr: true,
+		},
+		{
+			name:        "integer",
+			column:      int64(42),
+			expectedErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got uuidtypes.UUIDValue
+			err := db.QueryRowContext(context.Background(), "SELECT ?", testcase.column).Scan(&got)
+			if (err != nil) != testcase.expectedErr {
+				t.Fatalf("Scan()\nerror   : %v\nexpected error: %v", err, testcase.expectedErr)
+			}
+
+			if err == nil {
+				if diff := cmp.Diff(got, testcase.expected); diff != "" {
+					t.Errorf("Scan()\ngot     : %v\nexpected: %v\ndiff    : %s\n", got, testcase.expected, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestUUIDValue_Value(t *testing.T) {
+	t.Parallel()
+
+	db, err := sql.Open(echoDriverName, "")
+	if err != nil {
+		t.Fatalf("sql.Open() unexpected error: %v", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	tests := []struct {
+		name        string
+		value       uuidtypes.UUIDValue
+		expected    sql.NullString
+		expectedErr error
+	}{
+		{
+			name:     "null",
+			value:    uuidtypes.NewUUIDNull(),
+			expected: sql.NullString{},
+		},
+		{
+			name:        "unknown",
+			value:       uuidtypes.NewUUIDUnknown(),
+			expectedErr: uuidtypes.ErrUnknownValue,
+		},
+		{
+			name:     "value",
+			value:    uuidtypes.NewUUIDValue(valueUUIDv4),
+			expected: sql.NullString{String: valueUUIDv4, Valid: true},
+		},
+		{
+			name:     "value-urn-uppercase",
+			value:    uuidtypes.NewUUIDValue("urn:uuid:" + valueUUIDv4Upper),
+			expected: sql.NullString{String: valueUUIDv4, Valid: true},
+		},
+		{
+			name:        "value-invalid",
+			value:       uuidtypes.NewUUIDValue(valueInvalid),
+			expectedErr: &uuidtypes.ParseError{},
+		},
+	}
+
+	for _, testcase := range tests {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got sql.NullString
+			err := db.QueryRowContext(context.Background(), "SELECT ?", testcase.value).Scan(&got)
+			if !isExpectedErr(err, testcase.expectedErr) {
+				t.Fatalf("Value()\nerror   : %v\nexpected: %v", err, testcase.expectedErr)
+			}
+
+			if diff := cmp.Diff(got, testcase.expected); err == nil && diff != "" {
+				t.Errorf("Value()\ngot     : %v\nexpected: %v\ndiff    : %s\n", got, testcase.expected, diff)
+			}
+		})
+	}
+}
+
+func TestUUIDValue_ValueScan_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	db, err := sql.Open(echoDriverName, "")
+	if err != nil {
+		t.Fatalf("sql.Open() unexpected error: %v", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	expected := uuidtypes.NewUUIDValue(valueUUIDv4)
+
+	var got uuidtypes.UUIDValue
+	if err := db.QueryRowContext(context.Background(), "SELECT ?", expected).Scan(&got); err != nil {
+		t.Fatalf("Scan() unexpected error: %v", err)
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("Scan()\ngot     : %v\nexpected: %v\ndiff    : %s\n", got, expected, diff)
+	}
+}
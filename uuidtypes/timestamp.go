@@ -0,0 +1,64 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidtypes
+
+import (
+	// Standard Library Imports
+	"encoding/binary"
+	"time"
+)
+
+// gregorianToUnixOffset is the number of 100-nanosecond intervals between the
+// start of the Gregorian calendar, 1582-10-15T00:00:00Z, and the Unix Epoch,
+// 1970-01-01T00:00:00Z.
+const gregorianToUnixOffset = 122_192_928_000_000_000
+
+// TimestampOf returns the time embedded in a time-based UUID. Version 1 and
+// Version 6 UUIDs embed a count of 100-nanosecond intervals since the start
+// of the Gregorian calendar, while Version 7 UUIDs embed a count of
+// milliseconds since the Unix Epoch. If the UUID is not a time-based UUID
+// using the RFC 9562 variant, false is returned.
+func TimestampOf(uuid [16]byte) (time.Time, bool) {
+	if VariantOf(uuid) != VariantRFC9562 {
+		return time.Time{}, false
+	}
+
+	switch VersionOf(uuid) {
+	case Version1:
+		timeLow := uint64(binary.BigEndian.Uint32(uuid[0:4]))
+		timeMid := uint64(binary.BigEndian.Uint16(uuid[4:6]))
+		timeHigh := uint64(binary.BigEndian.Uint16(uuid[6:8]) & 0x0fff)
+
+		return gregorianTime(timeHigh<<48 | timeMid<<32 | timeLow), true
+
+	case Version6:
+		timeHigh := uint64(binary.BigEndian.Uint32(uuid[0:4]))
+		timeMid := uint64(binary.BigEndian.Uint16(uuid[4:6]))
+		timeLow := uint64(binary.BigEndian.Uint16(uuid[6:8]) & 0x0fff)
+
+		return gregorianTime(timeHigh<<28 | timeMid<<12 | timeLow), true
+
+	case Version7:
+		var unixMilli [8]byte
+		copy(unixMilli[2:], uuid[0:6])
+
+		return time.UnixMilli(int64(binary.BigEndian.Uint64(unixMilli[:]))).UTC(), true
+
+	default:
+		return time.Time{}, false
+	}
+}
+
+// gregorianTime converts a count of 100-nanosecond intervals since the start
+// of the Gregorian calendar into a UTC time.
+func gregorianTime(intervals uint64) time.Time {
+	unixIntervals := int64(intervals) - gregorianToUnixOffset
+
+	return time.Unix(unixIntervals/10_000_000, (unixIntervals%10_000_000)*100).UTC()
+}
@@ -0,0 +1,200 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidtypes_test
+
+import (
+	// Standard Library Imports
+	"testing"
+	"time"
+
+	// External Imports
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidtypes"
+)
+
+func TestTimestampOf(t *testing.T) {
+	t.Parallel()
+
+	// RFC 9562 Appendix A test vectors are generated at
+	// 2022-02-22T14:22:22-05:00.
+	rfcTime := time.Date(2022, time.February, 22, 19, 22, 22, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		value      string
+		expected   time.Time
+		expectedOk bool
+	}{
+		{
+			name:       "v1-rfc9562",
+			value:      "c232ab00-9414-11ec-b3c8-9f6bdeced846",
+			expected:   rfcTime,
+			expectedOk: true,
+		},
+		{
+			name:       "v1",
+			value:      valueUUIDv1,
+			expected:   time.Date(2022, time.October, 3, 10, 51, 17, 147_607_000, time.UTC),
+			expectedOk: true,
+		},
+		{
+			name:       "v1-gregorian-epoch",
+			value:      "00000000-0000-1000-8000-000000000000",
+			expected:   time.Date(1582, time.October, 15, 0, 0, 0, 0, time.UTC),
+			expectedOk: true,
+		},
+		{
+			name:       "v6-rfc9562",
+			value:      valueUUIDv6,
+			expected:   rfcTime,
+			expectedOk: true,
+		},
+		{
+			name:       "v7-rfc9562",
+			value:      valueUUIDv7,
+			expected:   rfcTime,
+			expectedOk: true,
+		},
+		{
+			name:       "v7-millisecond-precision",
+			value:      "017f22e2-79b1-7cc3-98c4-dc0c0c07398f",
+			expected:   rfcTime.Add(time.Millisecond),
+			expectedOk: true,
+		},
+		{
+			name:  "v4",
+			value: valueUUIDv4,
+		},
+		{
+			name:  "nil",
+			value: valueUUIDNil,
+		},
+		{
+			name:  "v1-variant-microsoft",
+			value: "c232ab00-9414-11ec-d3c8-9f6bdeced846",
+		},
+	}
+
+	for _, testcase := range tests {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			uuid, err := uuidtypes.Parse(testcase.value)
+			if err != nil {
+				t.Fatalf("Parse() unexpected error: %v", err)
+			}
+
+			got, ok := uuidtypes.TimestampOf(uuid)
+			if ok != testcase.expectedOk {
+				t.Fatalf("TimestampOf()\nok      : %v\nexpected: %v", ok, testcase.expectedOk)
+			}
+
+			if !got.Equal(testcase.expected) {
+				t.Errorf("TimestampOf()\ngot     : %v\nexpected: %v", got, testcase.expected)
+			}
+		})
+	}
+}
+
+func TestUUIDValue_Timestamp(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		value         uuidtypes.UUIDValue
+		expected      time.Time
+		expectedDiags diag.Diagnostics
+	}{
+		{
+			name:  "null",
+			value: uuidtypes.NewUUIDNull(),
+		},
+		{
+			name:  "unknown",
+			value: uuidtypes.NewUUIDUnknown(),
+		},
+		{
+			name:     "v1",
+			value:    uuidtypes.NewUUIDValue(valueUUIDv1),
+			expected: time.Date(2022, time.October, 3, 10, 51, 17, 147_607_000, time.UTC),
+		},
+		{
+			name:     "v6",
+			value:    uuidtypes.NewUUIDv6Value(valueUUIDv6).UUIDValue,
+			expected: time.Date(2022, time.February, 22, 19, 22, 22, 0, time.UTC),
+		},
+		{
+			name:     "v7",
+			value:    uuidtypes.NewUUIDValue(valueUUIDv7),
+			expected: time.Date(2022, time.February, 22, 19, 22, 22, 0, time.UTC),
+		},
+		{
+			name:  "v4",
+			value: uuidtypes.NewUUIDValue(valueUUIDv4),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid UUID Timestamp",
+					"A time-based UUID, Version 1, Version 6 or Version 7 using the RFC 9562 variant, was expected, "+
+						"but a Version 4 UUID using the RFC 9562 variant was provided, which does not contain a timestamp.\n\n"+
+						"Provided Value: \"eb6f148a-6637-4c6b-a4bb-b75b2a1b5a3c\"",
+				),
+			},
+		},
+		{
+			name:  "v1-variant-microsoft",
+			value: uuidtypes.NewUUIDValue("c232ab00-9414-11ec-d3c8-9f6bdeced846"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid UUID Timestamp",
+					"A time-based UUID, Version 1, Version 6 or Version 7 using the RFC 9562 variant, was expected, "+
+						"but a Version 1 UUID using the Microsoft variant was provided, which does not contain a timestamp.\n\n"+
+						"Provided Value: \"c232ab00-9414-11ec-d3c8-9f6bdeced846\"",
+				),
+			},
+		},
+		{
+			name:  "invalid",
+			value: uuidtypes.NewUUIDValue("not-a-uuid"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid UUID String Value",
+					"An unexpected error occurred attempting to parse a string value that was expected to be a valid UUID format. "+
+						"The expected UUID format is 00000000-0000-0000-0000-000000000000. "+
+						"For example, a Version 4 UUID is of the form 7b16fd41-cc23-4ef7-8aa9-c598350ccd18.\n\n"+
+						"Provided Value: \"not-a-uuid\"\n"+
+						"Parse Error: wrong length: expected 36 characters but got 10\n\n"+
+						"    not-a-uuid\n"+
+						"              ^",
+				),
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, gotDiags := testcase.value.Timestamp()
+			if !got.Equal(testcase.expected) {
+				t.Errorf("Timestamp()\ngot     : %v\nexpected: %v\n", got, testcase.expected)
+			}
+
+			if diff := cmp.Diff(gotDiags, testcase.expectedDiags); diff != "" {
+				t.Errorf("Timestamp() diag.Diagnostics\ngot     : %v\nexpected: %v\ndiff    : %s\n", gotDiags, testcase.expectedDiags, diff)
+			}
+		})
+	}
+}
@@ -9,7 +9,11 @@
 package uuidtypes
 
 import (
+	// Standard Library Imports
+	"fmt"
+
 	// External Imports
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
@@ -42,3 +46,65 @@ func NewUUIDPointerValue(value *string) UUIDValue {
 		StringValue: basetypes.NewStringPointerValue(value),
 	}
 }
+
+// NewUUIDValueFromBytes creates a UUID with a known value from its RFC 9562
+// big-endian 16 byte representation. The UUID is stored in the canonical
+// format.
+func NewUUIDValueFromBytes(uuid [16]byte) UUIDValue {
+	return NewUUIDValue(Encode(uuid, FormatCanonical))
+}
+
+// NewUUIDValueFromGUIDBytes creates a UUID with a known value from its
+// Microsoft GUID mixed-endian 16 byte representation, such as the bytes
+// returned by the .NET Guid.ToByteArray method. The UUID is stored in the
+// canonical format.
+func NewUUIDValueFromGUIDBytes(guid [16]byte) UUIDValue {
+	return NewUUIDValueFromBytes(FromGUIDBytes(guid))
+}
+
+// ParseUUIDValue creates a UUID with a known value by parsing a string in any
+// of the formats in FormatAll. Unlike NewUUIDValue, the string is validated
+// when the value is constructed, and the UUID is stored in the canonical
+// lowercase hyphenated format. If the string is not a valid UUID, a
+// *ParseError is returned.
+func ParseUUIDValue(value string) (UUIDValue, error) {
+	uuid, err := ParseFormat(value, FormatAll)
+	if err != nil {
+		return NewUUIDNull(), err
+	}
+
+	return NewUUIDValueFromBytes(uuid), nil
+}
+
+// MustUUIDValue is like ParseUUIDValue, but panics if the string is not a
+// valid UUID. It simplifies the construction of known-good values in tests and
+// package level variables.
+func MustUUIDValue(value string) UUIDValue {
+	out, err := ParseUUIDValue(value)
+	if err != nil {
+		panic(fmt.Sprintf("uuidtypes: MustUUIDValue(%q): %s", value, err))
+	}
+
+	return out
+}
+
+// DecodeUUIDValue creates a UUID with a known value by decoding a string in any
+// of the given formats, for example, FormatBase64URL. The UUID is stored in
+// the canonical format.
+func DecodeUUIDValue(value string, formats Format) (UUIDValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	uuid, err := ParseFormat(value, formats)
+	if err != nil {
+		diags.AddError(
+			"Invalid UUID String Value",
+			fmt.Sprintf("A UUID in the %s format was expected.\n\n", formats.orDefault())+
+				fmt.Sprintf("Provided Value: %q\n", value)+
+				fmt.Sprintf("Parse Error: %s", err.Error()),
+		)
+
+		return NewUUIDNull(), diags
+	}
+
+	return NewUUIDValueFromBytes(uuid), diags
+}
@@ -0,0 +1,214 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidtypes
+
+import (
+	// Standard Library Imports
+	"context"
+	"fmt"
+
+	// External Imports
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure Implementation matches the expected interfaces.
+var (
+	_ attr.TypeWithElementType                 = UUIDListType{}
+	_ basetypes.ListTypable                    = UUIDListType{}
+	_ xattr.TypeWithValidate                   = UUIDListType{}
+	_ basetypes.ListValuableWithSemanticEquals = UUIDListValue{}
+)
+
+// UUIDListType is a ListType of UUIDs which compares lists by the 16 byte
+// representation of their elements, so an API returning the same UUIDs in a
+// different case or textual format does not cause a difference.
+type UUIDListType struct {
+	// ElemType is the type of the list elements, for example, a configured
+	// UUIDType or UUIDv4Type{}. If nil, UUIDType{} is used.
+	ElemType attr.Type
+
+	// IgnoreOrder considers lists containing the same UUIDs in a different
+	// order semantically equal.
+	IgnoreOrder bool
+}
+
+// ElementType returns the type of the list elements.
+func (u UUIDListType) ElementType() attr.Type {
+	if u.ElemType == nil {
+		return UUIDType{}
+	}
+
+	return u.ElemType
+}
+
+// WithElementType returns a UUIDListType with the given element type.
+func (u UUIDListType) WithElementType(typ attr.Type) attr.TypeWithElementType {
+	return UUIDListType{
+		ElemType:    typ,
+		IgnoreOrder: u.IgnoreOrder,
+	}
+}
+
+// TerraformType returns the tftypes.Type that should be used to represent this
+// type.
+func (u UUIDListType) TerraformType(ctx context.Context) tftypes.Type {
+	return u.listType().TerraformType(ctx)
+}
+
+// Equal returns true if the two types are equal, including their element type.
+func (u UUIDListType) Equal(o attr.Type) bool {
+	other, ok := o.(UUIDListType)
+	if !ok {
+		return false
+	}
+
+	return u.ElementType().Equal(other.ElementType()) && u.IgnoreOrder == other.IgnoreOrder
+}
+
+// ApplyTerraform5AttributePathStep applies the given AttributePathStep to the
+// list.
+func (u UUIDListType) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (interface{}, error) {
+	return u.listType().ApplyTerraform5AttributePathStep(step)
+}
+
+// String returns a human-friendly version of the Type.
+func (u UUIDListType) String() string {
+	if u.IgnoreOrder {
+		return "uuidtypes.UUIDListType[" + u.ElementType().String() + " IgnoreOrder]"
+	}
+
+	return "uuidtypes.UUIDListType[" + u.ElementType().String() + "]"
+}
+
+// Validate ensures each element of the list is valid for the element type.
+func (u UUIDListType) Validate(ctx context.Context, value tftypes.Value, schemaPath path.Path) diag.Diagnostics {
+	return u.listType().Validate(ctx, value, schemaPath)
+}
+
+// ValueFromList converts a list value to a ListValuable.
+func (u UUIDListType) ValueFromList(_ context.Context, in basetypes.ListValue) (basetypes.ListValuable, diag.Diagnostics) {
+	return UUIDListValue{
+		ListValue: in,
+		listType:  u,
+	}, nil
+}
+
+// ValueFromTerraform returns a UUIDListValue value given a tftypes.Value.
+func (u UUIDListType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := u.listType().ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	listValue, ok := attrValue.(basetypes.ListValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	listValuable, diags := u.ValueFromList(ctx, listValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting ListValue to ListValuable: %v", diags)
+	}
+
+	return listValuable, nil
+}
+
+// ValueType returns attr.Value type returned by ValueFromTerraform.
+func (u UUIDListType) ValueType(context.Context) attr.Value {
+	return UUIDListValue{
+		listType: u,
+	}
+}
+
+// listType returns the basetypes.ListType the UUIDListType is based on.
+func (u UUIDListType) listType() basetypes.ListType {
+	return basetypes.ListType{
+		ElemType: u.ElementType(),
+	}
+}
+
+// UUIDListValue provides a concrete implementation of a list of UUIDs for the
+// Terraform Plugin framework.
+type UUIDListValue struct {
+	basetypes.ListValue
+
+	// listType is the UUIDListType that created the value.
+	listType UUIDListType
+}
+
+// Type returns the UUIDListType that created the UUIDListValue.
+func (u UUIDListValue) Type(_ context.Context) attr.Type {
+	return u.listType
+}
+
+// Equal returns true if the list is equal to the Value passed as an argument.
+func (u UUIDListValue) Equal(o attr.Value) bool {
+	other, ok := o.(UUIDListValue)
+	if !ok {
+		return false
+	}
+
+	return u.listType.Equal(other.listType) && u.ListValue.Equal(other.ListValue)
+}
+
+// ListSemanticEquals returns true if the given list is semantically equal to
+// the current list. Lists are equal if their elements parse to the same 16
+// byte UUIDs, in the same order unless IgnoreOrder is set on the UUIDListType.
+// Lists containing a null, unknown or invalid element are only equal if
+// identical.
+func (u UUIDListValue) ListSemanticEquals(ctx context.Context, newValuable basetypes.ListValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(UUIDListValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				fmt.Sprintf("Expected Value Type: %T\n", u)+
+				fmt.Sprintf("Got Value Type: %T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	return uuidElementsEqual(ctx, u.Elements(), newValue.Elements(), u.listType.IgnoreOrder), diags
+}
+
+// NewUUIDListNull creates a UUIDListValue with a null value.
+func NewUUIDListNull() UUIDListValue {
+	return UUIDListValue{
+		ListValue: basetypes.NewListNull(UUIDType{}),
+	}
+}
+
+// NewUUIDListUnknown creates a UUIDListValue with an unknown value.
+func NewUUIDListUnknown() UUIDListValue {
+	return UUIDListValue{
+		ListValue: basetypes.NewListUnknown(UUIDType{}),
+	}
+}
+
+// NewUUIDListValue creates a UUIDListValue containing the given UUIDs, with
+// an ElemType of UUIDType{}.
+func NewUUIDListValue(ctx context.Context, uuids []UUIDValue) (UUIDListValue, diag.Diagnostics) {
+	listValue, diags := UUIDsToListValue(ctx, uuids)
+	if diags.HasError() {
+		return NewUUIDListUnknown(), diags
+	}
+
+	return UUIDListValue{
+		ListValue: listValue,
+	}, diags
+}
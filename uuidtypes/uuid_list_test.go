@@ -0,0 +1,334 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidtypes_test
+
+import (
+	// Standard Library Imports
+	"context"
+	"testing"
+
+	// External Imports
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidtypes"
+)
+
+// uuidListValue returns a known UUIDListValue of the given type containing the
+// given UUID strings.
+func uuidListValue(listType uuidtypes.UUIDListType, values ...string) uuidtypes.UUIDListValue {
+	ctx := context.Background()
+
+	elements := make([]tftypes.Value, len(values))
+	for i, value := range values {
+		elements[i] = tftypes.NewValue(tftypes.String, value)
+	}
+
+	attrValue, _ := listType.ValueFromTerraform(ctx, tftypes.NewValue(listType.TerraformType(ctx), elements))
+
+	return attrValue.(uuidtypes.UUIDListValue)
+}
+
+func TestUUIDListType_Equal(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		listType uuidtypes.UUIDListType
+		other    attr.Type
+		expected bool
+	}{
+		{
+			name:     "default-element-type",
+			listType: uuidtypes.UUIDListType{},
+			other:    uuidtypes.UUIDListType{ElemType: uuidtypes.UUIDType{}},
+			expected: true,
+		},
+		{
+			name:     "different-element-type",
+			listType: uuidtypes.UUIDListType{},
+			other:    uuidtypes.UUIDListType{ElemType: uuidtypes.UUIDv4Type{}},
+			expected: false,
+		},
+		{
+			name:     "different-ignore-order",
+			listType: uuidtypes.UUIDListType{},
+			other:    uuidtypes.UUIDListType{IgnoreOrder: true},
+			expected: false,
+		},
+		{
+			name:     "list-type",
+			listType: uuidtypes.UUIDListType{},
+			other:    types.ListType{ElemType: uuidtypes.UUIDType{}},
+			expected: false,
+		},
+	}
+
+	for _, testcase := range tests {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testcase.listType.Equal(testcase.other)
+			if got != testcase.expected {
+				t.Errorf("Equal()\ngot     : %v\nexpected: %v\ndiff    : %s\n", got, testcase.expected, cmp.Diff(got, testcase.expected))
+			}
+		})
+	}
+}
+
+func TestUUIDListType_String(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		listType uuidtypes.UUIDListType
+		expected string
+	}{
+		{
+			name:     "default",
+			listType: uuidtypes.UUIDListType{},
+			expected: "uuidtypes.UUIDListType[uuidtypes.UUIDType]",
+		},
+		{
+			name:     "ignore-order",
+			listType: uuidtypes.UUIDListType{ElemType: uuidtypes.UUIDv4Type{}, IgnoreOrder: true},
+			expected: "uuidtypes.UUIDListType[uuidtypes.UUIDv4Type IgnoreOrder]",
+		},
+	}
+
+	for _, testcase := range tests {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testcase.listType.String()
+			if got != testcase.expected {
+				t.Errorf("String()\ngot     : %v\nexpected: %v\ndiff    : %s\n", got, testcase.expected, cmp.Diff(got, testcase.expected))
+			}
+		})
+	}
+}
+
+func TestUUIDListType_ValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	listType := uuidtypes.UUIDListType{IgnoreOrder: true}
+	terraformType := listType.TerraformType(ctx)
+
+	tests := []struct {
+		name     string
+		value    tftypes.Value
+		expected attr.Value
+	}{
+		{
+			name:     "null",
+			value:    tftypes.NewValue(terraformType, nil),
+			expected: mustValueFromList(listType, types.ListNull(uuidtypes.UUIDType{})),
+		},
+		{
+			name:     "unknown",
+			value:    tftypes.NewValue(terraformType, tftypes.UnknownValue),
+			expected: mustValueFromList(listType, types.ListUnknown(uuidtypes.UUIDType{})),
+		},
+		{
+			name: "known",
+			value: tftypes.NewValue(terraformType, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, valueUUIDv4),
+			}),
+			expected: mustValueFromList(listType, types.ListValueMust(uuidtypes.UUIDType{}, []attr.Value{
+				uuidtypes.NewUUIDValue(valueUUIDv4),
+			})),
+		},
+	}
+
+	for _, testcase := range tests {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := listType.ValueFromTerraform(ctx, testcase.value)
+			if err != nil {
+				t.Fatalf("ValueFromTerraform() unexpected error: %s", err)
+			}
+
+			if !got.Equal(testcase.expected) {
+				t.Errorf("ValueFromTerraform()\ngot     : %v\nexpected: %v\n", got, testcase.expected)
+			}
+
+			if !got.Type(ctx).Equal(listType) {
+				t.Errorf("Type()\ngot     : %v\nexpected: %v\n", got.Type(ctx), listType)
+			}
+		})
+	}
+}
+
+// mustValueFromList returns the UUIDListValue of the given type wrapping the
+// list.
+func mustValueFromList(listType uuidtypes.UUIDListType, list types.List) uuidtypes.UUIDListValue {
+	valuable, _ := listType.ValueFromList(context.Background(), list)
+
+	return valuable.(uuidtypes.UUIDListValue)
+}
+
+func TestUUIDListType_Validate(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	listType := uuidtypes.UUIDListType{ElemType: uuidtypes.UUIDv4Type{}}
+
+	value := tftypes.NewValue(listType.TerraformType(ctx), []tftypes.Value{
+		tftypes.NewValue(tftypes.String, valueUUIDv4),
+		tftypes.NewValue(tftypes.String, valueUUIDv5),
+	})
+
+	got := listType.Validate(ctx, value, path.Root("ids"))
+	if len(got) != 1 {
+		t.Fatalf("Validate() expected 1 diagnostic, got: %v", got)
+	}
+
+	withPath, ok := got[0].(diag.DiagnosticWithPath)
+	if !ok {
+		t.Fatalf("Validate() expected a diagnostic with a path, got: %v", got[0])
+	}
+
+	if expected := path.Root("ids").AtListIndex(1); !withPath.Path().Equal(expected) {
+		t.Errorf("Validate() path\ngot     : %v\nexpected: %v\n", withPath.Path(), expected)
+	}
+}
+
+func TestUUIDListValue_ListSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	ordered := uuidtypes.UUIDListType{}
+	unordered := uuidtypes.UUIDListType{IgnoreOrder: true}
+
+	tests := []struct {
+		name          string
+		current       uuidtypes.UUIDListValue
+		given         basetypes.ListValuable
+		expected      bool
+		expectedDiags diag.Diagnostics
+	}{
+		{
+			name:     "same-uuids",
+			current:  uuidListValue(ordered, valueUUIDv4, valueUUIDv5),
+			given:    uuidListValue(ordered, valueUUIDv4, valueUUIDv5),
+			expected: true,
+		},
+		{
+			name:     "different-case-and-format",
+			current:  uuidListValue(ordered, valueUUIDv4Upper, valueUUIDv5),
+			given:    uuidListValue(ordered, valueUUIDv4URN, valueUUIDv5),
+			expected: true,
+		},
+		{
+			name:     "different-uuids",
+			current:  uuidListValue(ordered, valueUUIDv4, valueUUIDv5),
+			given:    uuidListValue(ordered, valueUUIDv4, valueUUIDv3),
+			expected: false,
+		},
+		{
+			name:     "different-length",
+			current:  uuidListValue(ordered, valueUUIDv4),
+			given:    uuidListValue(ordered, valueUUIDv4, valueUUIDv4),
+			expected: false,
+		},
+		{
+			name:     "different-order",
+			current:  uuidListValue(ordered, valueUUIDv4, valueUUIDv5),
+			given:    uuidListValue(ordered, valueUUIDv5, valueUUIDv4),
+			expected: false,
+		},
+		{
+			name:     "different-order-ignored",
+			current:  uuidListValue(unordered, valueUUIDv4Upper, valueUUIDv5),
+			given:    uuidListValue(unordered, valueUUIDv5, valueUUIDv4),
+			expected: true,
+		},
+		{
+			name:     "different-duplicates-ignored-order",
+			current:  uuidListValue(unordered, valueUUIDv4, valueUUIDv4, valueUUIDv5),
+			given:    uuidListValue(unordered, valueUUIDv4, valueUUIDv5, valueUUIDv5),
+			expected: false,
+		},
+		{
+			name:     "invalid-element",
+			current:  uuidListValue(ordered, valueInvalid),
+			given:    uuidListValue(ordered, valueInvalid),
+			expected: false,
+		},
+		{
+			name:     "list-value",
+			current:  uuidListValue(ordered, valueUUIDv4),
+			given:    types.ListValueMust(uuidtypes.UUIDType{}, []attr.Value{uuidtypes.NewUUIDValue(valueUUIDv4)}),
+			expected: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: uuidtypes.UUIDListValue\n"+
+						"Got Value Type: basetypes.ListValue",
+				),
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, gotDiags := testcase.current.ListSemanticEquals(context.Background(), testcase.given)
+			if got != testcase.expected {
+				t.Errorf("ListSemanticEquals()\ngot     : %v\nexpected: %v\ndiff    : %s\n", got, testcase.expected, cmp.Diff(got, testcase.expected))
+			}
+
+			if diff := cmp.Diff(gotDiags, testcase.expectedDiags); diff != "" {
+				t.Errorf("ListSemanticEquals() diag.Diagnostics\ngot     : %v\nexpected: %v\ndiff    : %s\n", gotDiags, testcase.expectedDiags, diff)
+			}
+		})
+	}
+}
+
+func TestNewUUIDListValue(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	got, diags := uuidtypes.NewUUIDListValue(ctx, []uuidtypes.UUIDValue{uuidtypes.NewUUIDValue(valueUUIDv4)})
+	if diags.HasError() {
+		t.Fatalf("NewUUIDListValue() unexpected diagnostics: %v", diags)
+	}
+
+	expected := uuidListValue(uuidtypes.UUIDListType{}, valueUUIDv4)
+	if !got.Equal(expected) {
+		t.Errorf("NewUUIDListValue()\ngot     : %v\nexpected: %v\n", got, expected)
+	}
+
+	if !uuidtypes.NewUUIDListNull().Type(ctx).Equal(uuidtypes.UUIDListType{}) {
+		t.Errorf("NewUUIDListNull() unexpected type: %v", uuidtypes.NewUUIDListNull().Type(ctx))
+	}
+
+	if !uuidtypes.NewUUIDListUnknown().IsUnknown() {
+		t.Errorf("NewUUIDListUnknown() expected an unknown value")
+	}
+}
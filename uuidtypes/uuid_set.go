@@ -0,0 +1,204 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidtypes
+
+import (
+	// Standard Library Imports
+	"context"
+	"fmt"
+
+	// External Imports
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure Implementation matches the expected interfaces.
+var (
+	_ attr.TypeWithElementType                = UUIDSetType{}
+	_ basetypes.SetTypable                    = UUIDSetType{}
+	_ xattr.TypeWithValidate                  = UUIDSetType{}
+	_ basetypes.SetValuableWithSemanticEquals = UUIDSetValue{}
+)
+
+// UUIDSetType is a SetType of UUIDs which compares sets by the 16 byte
+// representation of their elements, so an API returning the same UUIDs in a
+// different case or textual format does not cause a difference.
+type UUIDSetType struct {
+	// ElemType is the type of the set elements, for example, a configured
+	// UUIDType or UUIDv4Type{}. If nil, UUIDType{} is used.
+	ElemType attr.Type
+}
+
+// ElementType returns the type of the set elements.
+func (u UUIDSetType) ElementType() attr.Type {
+	if u.ElemType == nil {
+		return UUIDType{}
+	}
+
+	return u.ElemType
+}
+
+// WithElementType returns a UUIDSetType with the given element type.
+func (u UUIDSetType) WithElementType(typ attr.Type) attr.TypeWithElementType {
+	return UUIDSetType{
+		ElemType: typ,
+	}
+}
+
+// TerraformType returns the tftypes.Type that should be used to represent this
+// type.
+func (u UUIDSetType) TerraformType(ctx context.Context) tftypes.Type {
+	return u.setType().TerraformType(ctx)
+}
+
+// Equal returns true if the two types are equal, including their element type.
+func (u UUIDSetType) Equal(o attr.Type) bool {
+	other, ok := o.(UUIDSetType)
+	if !ok {
+		return false
+	}
+
+	return u.ElementType().Equal(other.ElementType())
+}
+
+// ApplyTerraform5AttributePathStep applies the given AttributePathStep to the
+// set.
+func (u UUIDSetType) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (interface{}, error) {
+	return u.setType().ApplyTerraform5AttributePathStep(step)
+}
+
+// String returns a human-friendly version of the Type.
+func (u UUIDSetType) String() string {
+	return "uuidtypes.UUIDSetType[" + u.ElementType().String() + "]"
+}
+
+// Validate ensures each element of the set is valid for the element type.
+func (u UUIDSetType) Validate(ctx context.Context, value tftypes.Value, schemaPath path.Path) diag.Diagnostics {
+	return u.setType().Validate(ctx, value, schemaPath)
+}
+
+// ValueFromSet converts a set value to a SetValuable.
+func (u UUIDSetType) ValueFromSet(_ context.Context, in basetypes.SetValue) (basetypes.SetValuable, diag.Diagnostics) {
+	return UUIDSetValue{
+		SetValue: in,
+		setType:  u,
+	}, nil
+}
+
+// ValueFromTerraform returns a UUIDSetValue value given a tftypes.Value.
+func (u UUIDSetType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := u.setType().ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	setValue, ok := attrValue.(basetypes.SetValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	setValuable, diags := u.ValueFromSet(ctx, setValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting SetValue to SetValuable: %v", diags)
+	}
+
+	return setValuable, nil
+}
+
+// ValueType returns attr.Value type returned by ValueFromTerraform.
+func (u UUIDSetType) ValueType(context.Context) attr.Value {
+	return UUIDSetValue{
+		setType: u,
+	}
+}
+
+// setType returns the basetypes.SetType the UUIDSetType is based on.
+func (u UUIDSetType) setType() basetypes.SetType {
+	return basetypes.SetType{
+		ElemType: u.ElementType(),
+	}
+}
+
+// UUIDSetValue provides a concrete implementation of a set of UUIDs for the
+// Terraform Plugin framework.
+type UUIDSetValue struct {
+	basetypes.SetValue
+
+	// setType is the UUIDSetType that created the value.
+	setType UUIDSetType
+}
+
+// Type returns the UUIDSetType that created the UUIDSetValue.
+func (u UUIDSetValue) Type(_ context.Context) attr.Type {
+	return u.setType
+}
+
+// Equal returns true if the set is equal to the Value passed as an argument.
+func (u UUIDSetValue) Equal(o attr.Value) bool {
+	other, ok := o.(UUIDSetValue)
+	if !ok {
+		return false
+	}
+
+	return u.setType.Equal(other.setType) && u.SetValue.Equal(other.SetValue)
+}
+
+// SetSemanticEquals returns true if the given set is semantically equal to
+// the current set. Sets are equal if their elements parse to the same 16 byte
+// UUIDs, regardless of order. Sets containing a null, unknown or invalid
+// element are only equal if identical.
+func (u UUIDSetValue) SetSemanticEquals(ctx context.Context, newValuable basetypes.SetValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(UUIDSetValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				fmt.Sprintf("Expected Value Type: %T\n", u)+
+				fmt.Sprintf("Got Value Type: %T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	return uuidElementsEqual(ctx, u.Elements(), newValue.Elements(), true), diags
+}
+
+// NewUUIDSetNull creates a UUIDSetValue with a null value.
+func NewUUIDSetNull() UUIDSetValue {
+	return UUIDSetValue{
+		SetValue: basetypes.NewSetNull(UUIDType{}),
+	}
+}
+
+// NewUUIDSetUnknown creates a UUIDSetValue with an unknown value.
+func NewUUIDSetUnknown() UUIDSetValue {
+	return UUIDSetValue{
+		SetValue: basetypes.NewSetUnknown(UUIDType{}),
+	}
+}
+
+// NewUUIDSetValue creates a UUIDSetValue containing the given UUIDs, with
+// an ElemType of UUIDType{}.
+func NewUUIDSetValue(ctx context.Context, uuids []UUIDValue) (UUIDSetValue, diag.Diagnostics) {
+	setValue, diags := UUIDsToSetValue(ctx, uuids)
+	if diags.HasError() {
+		return NewUUIDSetUnknown(), diags
+	}
+
+	return UUIDSetValue{
+		SetValue: setValue,
+	}, diags
+}
@@ -0,0 +1,189 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidtypes_test
+
+import (
+	// Standard Library Imports
+	"context"
+	"testing"
+
+	// External Imports
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidtypes"
+)
+
+// uuidSetValue returns a known UUIDSetValue of the given type containing the
+// given UUID strings.
+func uuidSetValue(setType uuidtypes.UUIDSetType, values ...string) uuidtypes.UUIDSetValue {
+	ctx := context.Background()
+
+	elements := make([]tftypes.Value, len(values))
+	for i, value := range values {
+		elements[i] = tftypes.NewValue(tftypes.String, value)
+	}
+
+	attrValue, _ := setType.ValueFromTerraform(ctx, tftypes.NewValue(setType.TerraformType(ctx), elements))
+
+	return attrValue.(uuidtypes.UUIDSetValue)
+}
+
+func TestUUIDSetType_Equal(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		setType  uuidtypes.UUIDSetType
+		other    attr.Type
+		expected bool
+	}{
+		{
+			name:     "default-element-type",
+			setType:  uuidtypes.UUIDSetType{},
+			other:    uuidtypes.UUIDSetType{ElemType: uuidtypes.UUIDType{}},
+			expected: true,
+		},
+		{
+			name:     "different-element-type",
+			setType:  uuidtypes.UUIDSetType{},
+			other:    uuidtypes.UUIDSetType{ElemType: uuidtypes.UUIDType{DisallowNil: true}},
+			expected: false,
+		},
+		{
+			name:     "set-type",
+			setType:  uuidtypes.UUIDSetType{},
+			other:    types.SetType{ElemType: uuidtypes.UUIDType{}},
+			expected: false,
+		},
+	}
+
+	for _, testcase := range tests {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testcase.setType.Equal(testcase.other)
+			if got != testcase.expected {
+				t.Errorf("Equal()\ngot     : %v\nexpected: %v\ndiff    : %s\n", got, testcase.expected, cmp.Diff(got, testcase.expected))
+			}
+		})
+	}
+}
+
+func TestUUIDSetType_ValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	setType := uuidtypes.UUIDSetType{}
+
+	got, err := setType.ValueFromTerraform(ctx, tftypes.NewValue(setType.TerraformType(ctx), []tftypes.Value{
+		tftypes.NewValue(tftypes.String, valueUUIDv4),
+	}))
+	if err != nil {
+		t.Fatalf("ValueFromTerraform() unexpected error: %s", err)
+	}
+
+	expected, _ := uuidtypes.NewUUIDSetValue(ctx, []uuidtypes.UUIDValue{uuidtypes.NewUUIDValue(valueUUIDv4)})
+	if !got.Equal(expected) {
+		t.Errorf("ValueFromTerraform()\ngot     : %v\nexpected: %v\n", got, expected)
+	}
+
+	null, err := setType.ValueFromTerraform(ctx, tftypes.NewValue(setType.TerraformType(ctx), nil))
+	if err != nil {
+		t.Fatalf("ValueFromTerraform() unexpected error: %s", err)
+	}
+
+	if !null.Equal(uuidtypes.NewUUIDSetNull()) {
+		t.Errorf("ValueFromTerraform()\ngot     : %v\nexpected: %v\n", null, uuidtypes.NewUUIDSetNull())
+	}
+}
+
+func TestUUIDSetValue_SetSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	setType := uuidtypes.UUIDSetType{}
+
+	tests := []struct {
+		name          string
+		current       uuidtypes.UUIDSetValue
+		given         basetypes.SetValuable
+		expected      bool
+		expectedDiags diag.Diagnostics
+	}{
+		{
+			name:     "same-uuids",
+			current:  uuidSetValue(setType, valueUUIDv4, valueUUIDv5),
+			given:    uuidSetValue(setType, valueUUIDv4, valueUUIDv5),
+			expected: true,
+		},
+		{
+			name:     "different-case-and-order",
+			current:  uuidSetValue(setType, valueUUIDv4Upper, valueUUIDv5),
+			given:    uuidSetValue(setType, valueUUIDv5, valueUUIDv4),
+			expected: true,
+		},
+		{
+			name:     "different-uuids",
+			current:  uuidSetValue(setType, valueUUIDv4, valueUUIDv5),
+			given:    uuidSetValue(setType, valueUUIDv4, valueUUIDv3),
+			expected: false,
+		},
+		{
+			name:     "different-length",
+			current:  uuidSetValue(setType, valueUUIDv4Upper, valueUUIDv4),
+			given:    uuidSetValue(setType, valueUUIDv4),
+			expected: false,
+		},
+		{
+			name:     "invalid-element",
+			current:  uuidSetValue(setType, valueInvalid),
+			given:    uuidSetValue(setType, valueInvalid),
+			expected: false,
+		},
+		{
+			name:     "set-value",
+			current:  uuidSetValue(setType, valueUUIDv4),
+			given:    types.SetValueMust(uuidtypes.UUIDType{}, []attr.Value{uuidtypes.NewUUIDValue(valueUUIDv4)}),
+			expected: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: uuidtypes.UUIDSetValue\n"+
+						"Got Value Type: basetypes.SetValue",
+				),
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, gotDiags := testcase.current.SetSemanticEquals(context.Background(), testcase.given)
+			if got != testcase.expected {
+				t.Errorf("SetSemanticEquals()\ngot     : %v\nexpected: %v\ndiff    : %s\n", got, testcase.expected, cmp.Diff(got, testcase.expected))
+			}
+
+			if diff := cmp.Diff(gotDiags, testcase.expectedDiags); diff != "" {
+				t.Errorf("SetSemanticEquals() diag.Diagnostics\ngot     : %v\nexpected: %v\ndiff    : %s\n", gotDiags, testcase.expectedDiags, diff)
+			}
+		})
+	}
+}
@@ -10,10 +10,12 @@ package uuidtypes_test
 
 import (
 	// Standard Library Imports
+	"errors"
 	"testing"
 
 	// External Imports
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 
 	// Internal Imports
 	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidtypes"
@@ -61,3 +63,162 @@ func TestNewUUID(t *testing.T) {
 		})
 	}
 }
+
+func TestDecodeUUIDValue(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		value         string
+		formats       uuidtypes.Format
+		expected      uuidtypes.UUIDValue
+		expectedDiags diag.Diagnostics
+	}{
+		{
+			name:     "base64url",
+			value:    valueUUIDv4Base64URL,
+			formats:  uuidtypes.FormatBase64URL,
+			expected: uuidtypes.NewUUIDValue(valueUUIDv4),
+		},
+		{
+			name:     "base32",
+			value:    valueUUIDv4Base32,
+			formats:  uuidtypes.FormatBase32,
+			expected: uuidtypes.NewUUIDValue(valueUUIDv4),
+		},
+		{
+			name:     "encoded-or-hex",
+			value:    valueUUIDv4Hex,
+			formats:  uuidtypes.FormatEncoded | uuidtypes.FormatHex,
+			expected: uuidtypes.NewUUIDValue(valueUUIDv4),
+		},
+		{
+			name:     "invalid",
+			value:    "628UimY3TGuku7db+htaPA",
+			formats:  uuidtypes.FormatBase64URL,
+			expected: uuidtypes.NewUUIDNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid UUID String Value",
+					"A UUID in the base64url format was expected.\n\n"+
+						"Provided Value: \"628UimY3TGuku7db+htaPA\"\n"+
+						"Parse Error: invalid encoded character: found '+' at offset 16",
+				),
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, gotDiags := uuidtypes.DecodeUUIDValue(testcase.value, testcase.formats)
+			if diff := cmp.Diff(got, testcase.expected); diff != "" {
+				t.Errorf("DecodeUUIDValue()\ngot     : %v\nexpected: %v\ndiff    : %s\n", got, testcase.expected, diff)
+			}
+
+			if diff := cmp.Diff(gotDiags, testcase.expectedDiags); diff != "" {
+				t.Errorf("DecodeUUIDValue() diag.Diagnostics\ngot     : %v\nexpected: %v\ndiff    : %s\n", gotDiags, testcase.expectedDiags, diff)
+			}
+		})
+	}
+}
+
+func TestParseUUIDValue(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		value       string
+		expected    uuidtypes.UUIDValue
+		expectedErr *uuidtypes.ParseError
+	}{
+		{
+			name:     "canonical",
+			value:    valueUUIDv4,
+			expected: uuidtypes.NewUUIDValue(valueUUIDv4),
+		},
+		{
+			name:     "uppercase",
+			value:    valueUUIDv4Upper,
+			expected: uuidtypes.NewUUIDValue(valueUUIDv4),
+		},
+		{
+			name:     "braced",
+			value:    valueUUIDv4Braced,
+			expected: uuidtypes.NewUUIDValue(valueUUIDv4),
+		},
+		{
+			name:     "urn",
+			value:    valueUUIDv4URN,
+			expected: uuidtypes.NewUUIDValue(valueUUIDv4),
+		},
+		{
+			name:     "hex",
+			value:    valueUUIDv4Hex,
+			expected: uuidtypes.NewUUIDValue(valueUUIDv4),
+		},
+		{
+			name:     "invalid",
+			value:    valueInvalid,
+			expected: uuidtypes.NewUUIDNull(),
+			expectedErr: &uuidtypes.ParseError{
+				Value:  valueInvalid,
+				Kind:   uuidtypes.ParseErrorInvalidHex,
+				Offset: 2,
+				Msg:    "found 't' at offset 2",
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := uuidtypes.ParseUUIDValue(testcase.value)
+			if testcase.expectedErr != nil {
+				var parseErr *uuidtypes.ParseError
+				if !errors.As(err, &parseErr) {
+					t.Fatalf("ParseUUIDValue()\nerror   : %v\nexpected: *uuidtypes.ParseError", err)
+				}
+
+				if diff := cmp.Diff(*parseErr, *testcase.expectedErr); diff != "" {
+					t.Errorf("ParseUUIDValue()\nerror   : %+v\nexpected: %+v\ndiff    : %s", *parseErr, *testcase.expectedErr, diff)
+				}
+			} else if err != nil {
+				t.Fatalf("ParseUUIDValue() unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(got, testcase.expected); diff != "" {
+				t.Errorf("ParseUUIDValue()\ngot     : %v\nexpected: %v\ndiff    : %s\n", got, testcase.expected, diff)
+			}
+		})
+	}
+}
+
+func TestMustUUIDValue(t *testing.T) {
+	t.Parallel()
+
+	got := uuidtypes.MustUUIDValue(valueUUIDv4Upper)
+	expected := uuidtypes.NewUUIDValue(valueUUIDv4)
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("MustUUIDValue()\ngot     : %v\nexpected: %v\ndiff    : %s\n", got, expected, diff)
+	}
+}
+
+func TestMustUUIDValue_Panics(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		expected := `uuidtypes: MustUUIDValue("not-a-uuid-at-all"): wrong length: expected 36 characters but got 17`
+		if got := recover(); got != expected {
+			t.Errorf("MustUUIDValue()\npanic   : %v\nexpected: %v\n", got, expected)
+		}
+	}()
+
+	uuidtypes.MustUUIDValue(valueInvalidLength)
+}
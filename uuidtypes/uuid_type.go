@@ -12,12 +12,14 @@ import (
 	// Standard Library Imports
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 
 	// External Imports
-	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -31,26 +33,108 @@ var (
 	_ xattr.TypeWithValidate       = UUIDType{}
 )
 
+// UUIDType is a StringType which validates values as UUIDs. The zero value
+// accepts any UUID in the canonical hyphenated format, optional fields can be
+// set to constrain the UUIDs accepted.
 type UUIDType struct {
 	basetypes.StringType
+
+	// Versions restricts the accepted UUIDs to the given versions using the
+	// RFC 9562 variant, for example, NewVersionSet(Version4, Version7). If
+	// empty, UUIDs of any version and variant are accepted. The Nil and Max
+	// UUIDs are exempt, and are only rejected by setting DisallowNil and
+	// DisallowMax.
+	Versions VersionSet
+
+	// DisallowNil rejects the Nil UUID, 00000000-0000-0000-0000-000000000000.
+	DisallowNil bool
+
+	// DisallowMax rejects the Max UUID, ffffffff-ffff-ffff-ffff-ffffffffffff.
+	DisallowMax bool
+
+	// Formats is the set of textual formats accepted. If zero, only
+	// FormatCanonical is accepted.
+	Formats Format
+
+	// DisallowUppercase rejects UUIDs containing uppercase hex digits.
+	DisallowUppercase bool
+
+	// Strict enforces the UUID conforms to RFC 9562, requiring the UUID to be
+	// the Nil UUID, the Max UUID, or use the RFC 9562 variant with a version
+	// defined by RFC 9562.
+	Strict bool
+
+	// StrictConversion stores UUIDs conforming to the type's policy in the
+	// canonical lowercase format when a value is created by ValueFromString,
+	// and therefore ValueFromTerraform. Invalid UUIDs are left unchanged, so
+	// Validate reports them against the attribute with the usual diagnostics.
+	//
+	// Terraform requires planned values to match configuration, so when
+	// enabled, ensure configured values are already canonical by leaving
+	// Formats unset and setting DisallowUppercase.
+	StrictConversion bool
 }
 
-// Equal returns true if the two values are equal.
+// Equal returns true if the two types are equal, including their policy.
 func (u UUIDType) Equal(o attr.Type) bool {
 	other, ok := o.(UUIDType)
 	if !ok {
 		return false
 	}
 
-	return u.StringType.Equal(other.StringType)
+	return u.StringType.Equal(other.StringType) &&
+		u.Versions == other.Versions &&
+		u.DisallowNil == other.DisallowNil &&
+		u.DisallowMax == other.DisallowMax &&
+		u.Formats.orDefault() == other.Formats.orDefault() &&
+		u.DisallowUppercase == other.DisallowUppercase &&
+		u.Strict == other.Strict &&
+		u.StrictConversion == other.StrictConversion
 }
 
 // String returns a human-friendly version of the Type.
 func (u UUIDType) String() string {
-	return "uuidtypes.UUIDType"
+	var policy []string
+	if versions := u.Versions.Versions(); len(versions) > 0 {
+		names := make([]string, len(versions))
+		for i, version := range versions {
+			names[i] = strconv.Itoa(int(version))
+		}
+
+		policy = append(policy, "Versions="+strings.Join(names, ","))
+	}
+	if formats := u.Formats.orDefault(); formats != FormatCanonical {
+		policy = append(policy, "Formats="+formats.String())
+	}
+	if u.DisallowNil {
+		policy = append(policy, "DisallowNil")
+	}
+	if u.DisallowMax {
+		policy = append(policy, "DisallowMax")
+	}
+	if u.DisallowUppercase {
+		policy = append(policy, "DisallowUppercase")
+	}
+	if u.Strict {
+		policy = append(policy, "Strict")
+	}
+	if u.StrictConversion {
+		policy = append(policy, "StrictConversion")
+	}
+
+	if len(policy) == 0 {
+		return "uuidtypes.UUIDType"
+	}
+
+	return "uuidtypes.UUIDType[" + strings.Join(policy, " ") + "]"
 }
 
-// Validate ensures the value is a valid UUID.
+// Validate ensures the value is a valid UUID conforming to the type's policy.
+// The framework calls this with the type declared in the schema whenever an
+// attribute value is read from, or written to, configuration, plan or state,
+// so values are validated against the attribute's policy regardless of how
+// they were created, including those created by NewUUIDValue. Null and unknown
+// values are not validated.
 func (u UUIDType) Validate(_ context.Context, value tftypes.Value, schemaPath path.Path) diag.Diagnostics {
 	if value.IsNull() || !value.IsKnown() {
 		return nil
@@ -71,37 +155,186 @@ func (u UUIDType) Validate(_ context.Context, value tftypes.Value, schemaPath pa
 		return diags
 	}
 
-	if _, err := uuid.ParseUUID(valueString); err != nil {
-		diags.AddAttributeError(
-			schemaPath,
+	return u.validateAttribute(basetypes.NewStringValue(valueString), schemaPath)
+}
+
+// validateAttribute ensures an attribute value is a UUID conforming to the
+// type's policy, with diagnostics reported against the attribute path. Null and
+// unknown values are not validated.
+func (u UUIDType) validateAttribute(value basetypes.StringValue, attributePath path.Path) diag.Diagnostics {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	var diags diag.Diagnostics
+
+	_, validateDiags := u.validate(value.ValueString())
+	for _, d := range validateDiags {
+		diags.Append(diag.WithPath(attributePath, d))
+	}
+
+	return diags
+}
+
+// validateParameter ensures a function argument at the given position is a
+// UUID conforming to the type's policy. Null and unknown values are not
+// validated.
+func (u UUIDType) validateParameter(ctx context.Context, value basetypes.StringValue, position int64) *function.FuncError {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	_, diags := u.validate(value.ValueString())
+	if !diags.HasError() {
+		return nil
+	}
+
+	return function.NewArgumentFuncError(position, function.FuncErrorFromDiags(ctx, diags).Error())
+}
+
+// validate parses the string value and ensures the UUID conforms to the
+// type's policy.
+func (u UUIDType) validate(value string) ([16]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	uuid, format, err := parseUUIDFormat(value, u.Formats, u.Strict)
+	if err != nil {
+		diags.AddError(
 			"Invalid UUID String Value",
-			"An unexpected error occurred attempting to parse a string value that was expected to be a valid UUID format. "+
-				"The expected UUID format is 00000000-0000-0000-0000-00000000. "+
-				"For example, a Version 4 UUID is of the form 7b16fd41-cc23-4ef7-8aa9-c598350ccd18.\n\n"+
-				fmt.Sprintf("Provided Value: %q\n", valueString)+
-				fmt.Sprintf("Parse Error: %s", err.Error()),
+			invalidUUIDStringDetail(value, err),
 		)
 
-		return diags
+		return uuid, diags
 	}
 
-	return diags
+	// Letter case is significant in the base64url encoding and ignored in the
+	// base32 encoding, so only hex digits are checked.
+	if u.DisallowUppercase && format&FormatEncoded == 0 && hasUppercaseHex(value) {
+		diags.AddError(
+			"Invalid UUID String Value",
+			"A UUID using lowercase hex digits was expected, but uppercase hex digits were provided.\n\n"+
+				fmt.Sprintf("Provided Value: %q\n", value)+
+				fmt.Sprintf("Suggested Value: %q", strings.ToLower(value)),
+		)
+	}
+
+	// The Nil and Max UUIDs have no version, so are exempt from Versions.
+	switch uuid {
+	case NilUUID:
+		if u.DisallowNil {
+			diags.AddError(
+				"Invalid UUID Value",
+				"The Nil UUID is not permitted.\n\n"+
+					fmt.Sprintf("Provided Value: %q", value),
+			)
+		}
+
+		return uuid, diags
+
+	case MaxUUID:
+		if u.DisallowMax {
+			diags.AddError(
+				"Invalid UUID Value",
+				"The Max UUID is not permitted.\n\n"+
+					fmt.Sprintf("Provided Value: %q", value),
+			)
+		}
+
+		return uuid, diags
+	}
+
+	if u.Versions == 0 {
+		return uuid, diags
+	}
+
+	if actual := VersionOf(uuid); !u.Versions.Contains(actual) {
+		diags.AddError(
+			"Invalid UUID Version",
+			fmt.Sprintf("A %s UUID was expected, but a Version %d UUID was provided.\n\n", versionList(u.Versions.Versions()), actual)+
+				fmt.Sprintf("Provided Value: %q", value),
+		)
+	}
+
+	if actual := VariantOf(uuid); actual != VariantRFC9562 {
+		diags.AddError(
+			"Invalid UUID Variant",
+			fmt.Sprintf("A UUID using the %s variant was expected, but a UUID using the %s variant was provided.\n\n", VariantRFC9562, actual)+
+				fmt.Sprintf("Provided Value: %q", value),
+		)
+	}
+
+	return uuid, diags
 }
 
-// ValueFromString converts a string value to a StringValuable.
+// ValueFromString converts a string value to a StringValuable. If
+// StrictConversion is set, a UUID conforming to the type's policy is stored in
+// the canonical lowercase format. The string is otherwise stored as given,
+// leaving validation to Validate.
 func (u UUIDType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
 	value := UUIDValue{
 		StringValue: in,
+		uuidType:    u,
+	}
+
+	if !u.StrictConversion || in.IsNull() || in.IsUnknown() {
+		return value, nil
 	}
 
-	// TODO: not sure if should validate the UUID here given diags are returned...?
+	// Invalid UUIDs are reported by Validate, with the attribute path.
+	uuid, diags := u.validate(in.ValueString())
+	if diags.HasError() {
+		return value, nil
+	}
+
+	value.StringValue = basetypes.NewStringValue(Encode(uuid, FormatCanonical))
 
 	return value, nil
 }
 
+// NewNull creates a UUIDValue of the type with a null value.
+func (u UUIDType) NewNull() UUIDValue {
+	return UUIDValue{
+		StringValue: basetypes.NewStringNull(),
+		uuidType:    u,
+	}
+}
+
+// NewUnknown creates a UUIDValue of the type with an unknown value.
+func (u UUIDType) NewUnknown() UUIDValue {
+	return UUIDValue{
+		StringValue: basetypes.NewStringUnknown(),
+		uuidType:    u,
+	}
+}
+
+// NewValue creates a UUIDValue of the type with a known value. As with
+// NewUUIDValue, the string is stored as given, deferring validation against
+// the type's policy to Terraform. Use this, rather than NewUUIDValue, where
+// the value must be of a configured type, for example, as the element of a
+// list with a configured ElementType.
+func (u UUIDType) NewValue(value string) UUIDValue {
+	return UUIDValue{
+		StringValue: basetypes.NewStringValue(value),
+		uuidType:    u,
+	}
+}
+
 // ValueFromTerraform returns a UUIDValue value given a tftypes.Value.
 func (u UUIDType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
-	attrValue, err := u.StringType.ValueFromTerraform(ctx, in)
+	return valueFromTerraform(ctx, u, in)
+}
+
+// ValueType returns attr.Value type returned by ValueFromTerraform.
+func (u UUIDType) ValueType(context.Context) attr.Value {
+	return UUIDValue{
+		uuidType: u,
+	}
+}
+
+// valueFromTerraform converts a tftypes.Value into the StringValuable of the
+// given UUID type.
+func valueFromTerraform(ctx context.Context, typ basetypes.StringTypable, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := basetypes.StringType{}.ValueFromTerraform(ctx, in)
 	if err != nil {
 		return nil, err
 	}
@@ -111,15 +344,10 @@ func (u UUIDType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (att
 		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
 	}
 
-	stringValuable, diags := u.ValueFromString(ctx, stringValue)
+	stringValuable, diags := typ.ValueFromString(ctx, stringValue)
 	if diags.HasError() {
 		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
 	}
 
 	return stringValuable, nil
 }
-
-// ValueType returns attr.Value type returned by ValueFromTerraform.
-func (u UUIDType) ValueType(context.Context) attr.Value {
-	return UUIDValue{}
-}
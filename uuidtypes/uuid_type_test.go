@@ -19,6 +19,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -32,6 +34,7 @@ func TestUUIDType_Equal(t *testing.T) {
 
 	tests := []struct {
 		name     string
+		uuidType uuidtypes.UUIDType
 		other    attr.Type
 		expected bool
 	}{
@@ -65,6 +68,66 @@ func TestUUIDType_Equal(t *testing.T) {
 			other:    types.Float64Type,
 			expected: false,
 		},
+		{
+			name:     "policy-zero-canonical-format",
+			other:    uuidtypes.UUIDType{Formats: uuidtypes.FormatCanonical},
+			expected: true,
+		},
+		{
+			name:     "policy-zero-versions",
+			other:    uuidtypes.UUIDType{Versions: uuidtypes.NewVersionSet(uuidtypes.Version4)},
+			expected: false,
+		},
+		{
+			name:     "policy-zero-disallow-nil",
+			other:    uuidtypes.UUIDType{DisallowNil: true},
+			expected: false,
+		},
+		{
+			name:     "policy-zero-disallow-max",
+			other:    uuidtypes.UUIDType{DisallowMax: true},
+			expected: false,
+		},
+		{
+			name:     "policy-zero-formats",
+			other:    uuidtypes.UUIDType{Formats: uuidtypes.FormatCanonical | uuidtypes.FormatBraced},
+			expected: false,
+		},
+		{
+			name:     "policy-zero-disallow-uppercase",
+			other:    uuidtypes.UUIDType{DisallowUppercase: true},
+			expected: false,
+		},
+		{
+			name:     "policy-zero-strict-conversion",
+			other:    uuidtypes.UUIDType{StrictConversion: true},
+			expected: false,
+		},
+		{
+			name: "policy-versions-unordered",
+			uuidType: uuidtypes.UUIDType{
+				Versions: uuidtypes.NewVersionSet(uuidtypes.Version4, uuidtypes.Version7),
+			},
+			other: uuidtypes.UUIDType{
+				Versions: uuidtypes.NewVersionSet(uuidtypes.Version7, uuidtypes.Version4, uuidtypes.Version7),
+			},
+			expected: true,
+		},
+		{
+			name: "policy-versions-different",
+			uuidType: uuidtypes.UUIDType{
+				Versions: uuidtypes.NewVersionSet(uuidtypes.Version4, uuidtypes.Version7),
+			},
+			other: uuidtypes.UUIDType{
+				Versions: uuidtypes.NewVersionSet(uuidtypes.Version4),
+			},
+			expected: false,
+		},
+		{
+			name:     "uuidtypes.UUIDv4Type",
+			other:    uuidtypes.UUIDv4Type{},
+			expected: false,
+		},
 	}
 	for _, testcase := range tests {
 		testcase := testcase
@@ -72,8 +135,7 @@ func TestUUIDType_Equal(t *testing.T) {
 		t.Run(testcase.name, func(t *testing.T) {
 			t.Parallel()
 
-			uuidType := uuidtypes.UUIDType{}
-			if got := uuidType.Equal(testcase.other); got != testcase.expected {
+			if got := testcase.uuidType.Equal(testcase.other); got != testcase.expected {
 				t.Errorf("Equal()\ngot     : %v\nexpected: %v", got, testcase.expected)
 			}
 		})
@@ -85,12 +147,31 @@ func TestUUIDType_String(t *testing.T) {
 
 	tests := []struct {
 		name     string
+		uuidType uuidtypes.UUIDType
 		expected string
 	}{
 		{
-			name:     "always",
+			name:     "zero",
+			expected: "uuidtypes.UUIDType",
+		},
+		{
+			name:     "canonical-format",
+			uuidType: uuidtypes.UUIDType{Formats: uuidtypes.FormatCanonical},
 			expected: "uuidtypes.UUIDType",
 		},
+		{
+			name: "policy",
+			uuidType: uuidtypes.UUIDType{
+				Versions:          uuidtypes.NewVersionSet(uuidtypes.Version7, uuidtypes.Version4),
+				DisallowNil:       true,
+				DisallowMax:       true,
+				Formats:           uuidtypes.FormatCanonical | uuidtypes.FormatURN,
+				DisallowUppercase: true,
+				Strict:            true,
+				StrictConversion:  true,
+			},
+			expected: "uuidtypes.UUIDType[Versions=4,7 Formats=canonical|urn DisallowNil DisallowMax DisallowUppercase Strict StrictConversion]",
+		},
 	}
 
 	for _, testcase := range tests {
@@ -99,8 +180,7 @@ func TestUUIDType_String(t *testing.T) {
 		t.Run(testcase.name, func(t *testing.T) {
 			t.Parallel()
 
-			uuidType := uuidtypes.UUIDType{}
-			got := uuidType.String()
+			got := testcase.uuidType.String()
 
 			if diff := cmp.Diff(got, testcase.expected); diff != "" {
 				t.Errorf("String()\ngot     : %s\nexpected: %s\ndiff    : %s", got, testcase.expected, diff)
@@ -151,10 +231,12 @@ func TestUUIDType_Validate(t *testing.T) {
 					path.Root("test"),
 					"Invalid UUID String Value",
 					"An unexpected error occurred attempting to parse a string value that was expected to be a valid UUID format. "+
-						"The expected UUID format is 00000000-0000-0000-0000-00000000. "+
+						"The expected UUID format is 00000000-0000-0000-0000-000000000000. "+
 						"For example, a Version 4 UUID is of the form 7b16fd41-cc23-4ef7-8aa9-c598350ccd18.\n\n"+
 						"Provided Value: \"not-a-uuid-at-all\"\n"+
-						"Parse Error: uuid string is wrong length",
+						"Parse Error: wrong length: expected 36 characters but got 17\n\n"+
+						"    not-a-uuid-at-all\n"+
+						"                     ^",
 				),
 			},
 		},
@@ -167,10 +249,12 @@ func TestUUIDType_Validate(t *testing.T) {
 					path.Root("test"),
 					"Invalid UUID String Value",
 					"An unexpected error occurred attempting to parse a string value that was expected to be a valid UUID format. "+
-						"The expected UUID format is 00000000-0000-0000-0000-00000000. "+
+						"The expected UUID format is 00000000-0000-0000-0000-000000000000. "+
 						"For example, a Version 4 UUID is of the form 7b16fd41-cc23-4ef7-8aa9-c598350ccd18.\n\n"+
 						"Provided Value: \"actually-not-04a00-UUID-valueat0all0\"\n"+
-						"Parse Error: uuid is improperly formatted",
+						"Parse Error: invalid hex character: found 't' at offset 2\n\n"+
+						"    actually-not-04a00-UUID-valueat0all0\n"+
+						"      ^",
 				),
 			},
 		},
@@ -314,10 +398,15 @@ func TestUUIDType_ValueType(t *testing.T) {
 		expected attr.Value
 	}{
 		{
-			name:     "always",
+			name:     "zero",
 			value:    uuidtypes.UUIDType{},
 			expected: uuidtypes.UUIDValue{},
 		},
+		{
+			name:     "policy",
+			value:    uuidtypes.UUIDType{DisallowNil: true},
+			expected: uuidtypes.UUIDType{DisallowNil: true}.ValueType(context.Background()),
+		},
 	}
 	for _, testcase := range tests {
 		testcase := testcase
@@ -399,3 +488,525 @@ func TestUUIDType_ValueFromString(t *testing.T) {
 		})
 	}
 }
+
+func TestUUIDType_Validate_Policy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		uuidType uuidtypes.UUIDType
+		value    string
+		expected diag.Diagnostics
+	}{
+		{
+			name:  "zero-nil",
+			value: valueUUIDNil,
+		},
+		{
+			name:  "zero-max",
+			value: valueUUIDMax,
+		},
+		{
+			name:  "zero-uppercase",
+			value: valueUUIDv4Upper,
+		},
+		{
+			name:  "zero-variant-microsoft",
+			value: valueUUIDv4VariantMicrosoft,
+		},
+		{
+			name:     "disallow-nil",
+			uuidType: uuidtypes.UUIDType{DisallowNil: true},
+			value:    valueUUIDNil,
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid UUID Value",
+					"The Nil UUID is not permitted.\n\n"+
+						"Provided Value: \"00000000-0000-0000-0000-000000000000\"",
+				),
+			},
+		},
+		{
+			name:     "disallow-max",
+			uuidType: uuidtypes.UUIDType{DisallowMax: true},
+			value:    valueUUIDMax,
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid UUID Value",
+					"The Max UUID is not permitted.\n\n"+
+						"Provided Value: \"ffffffff-ffff-ffff-ffff-ffffffffffff\"",
+				),
+			},
+		},
+		{
+			name:     "versions-nil-allowed",
+			uuidType: uuidtypes.UUIDType{Versions: uuidtypes.NewVersionSet(uuidtypes.Version4)},
+			value:    valueUUIDNil,
+		},
+		{
+			name:     "versions-max-allowed",
+			uuidType: uuidtypes.UUIDType{Versions: uuidtypes.NewVersionSet(uuidtypes.Version4)},
+			value:    valueUUIDMax,
+		},
+		{
+			name:     "versions-disallow-nil",
+			uuidType: uuidtypes.UUIDType{Versions: uuidtypes.NewVersionSet(uuidtypes.Version4), DisallowNil: true},
+			value:    valueUUIDNil,
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid UUID Value",
+					"The Nil UUID is not permitted.\n\n"+
+						"Provided Value: \"00000000-0000-0000-0000-000000000000\"",
+				),
+			},
+		},
+		{
+			name:     "versions-disallow-max",
+			uuidType: uuidtypes.UUIDType{Versions: uuidtypes.NewVersionSet(uuidtypes.Version4), DisallowMax: true},
+			value:    valueUUIDMax,
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid UUID Value",
+					"The Max UUID is not permitted.\n\n"+
+						"Provided Value: \"ffffffff-ffff-ffff-ffff-ffffffffffff\"",
+				),
+			},
+		},
+		{
+			name:     "versions-valid",
+			uuidType: uuidtypes.UUIDType{Versions: uuidtypes.NewVersionSet(uuidtypes.Version4, uuidtypes.Version7)},
+			value:    valueUUIDv7,
+		},
+		{
+			name:     "versions-invalid",
+			uuidType: uuidtypes.UUIDType{Versions: uuidtypes.NewVersionSet(uuidtypes.Version7, uuidtypes.Version4, uuidtypes.Version6)},
+			value:    valueUUIDv1,
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid UUID Version",
+					"A Version 4, Version 6 or Version 7 UUID was expected, but a Version 1 UUID was provided.\n\n"+
+						"Provided Value: \"4ea3c666-4309-11ed-b878-0242ac120002\"",
+				),
+			},
+		},
+		{
+			name:     "versions-invalid-variant",
+			uuidType: uuidtypes.UUIDType{Versions: uuidtypes.NewVersionSet(uuidtypes.Version4)},
+			value:    valueUUIDv4VariantMicrosoft,
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid UUID Variant",
+					"A UUID using the RFC 9562 variant was expected, but a UUID using the Microsoft variant was provided.\n\n"+
+						"Provided Value: \"eb6f148a-6637-4c6b-c4bb-b75b2a1b5a3c\"",
+				),
+			},
+		},
+		{
+			name:     "disallow-uppercase",
+			uuidType: uuidtypes.UUIDType{DisallowUppercase: true},
+			value:    valueUUIDv4Upper,
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid UUID String Value",
+					"A UUID using lowercase hex digits was expected, but uppercase hex digits were provided.\n\n"+
+						"Provided Value: \"EB6F148A-6637-4C6B-A4BB-B75B2A1B5A3C\"\n"+
+						"Suggested Value: \"eb6f148a-6637-4c6b-a4bb-b75b2a1b5a3c\"",
+				),
+			},
+		},
+		{
+			name:     "disallow-uppercase-urn-prefix",
+			uuidType: uuidtypes.UUIDType{DisallowUppercase: true, Formats: uuidtypes.FormatURN},
+			value:    "URN:UUID:" + valueUUIDv4,
+		},
+		{
+			name:     "whitespace-trailing",
+			uuidType: uuidtypes.UUIDType{},
+			value:    valueUUIDv4Upper + " ",
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid UUID String Value",
+					"An unexpected error occurred attempting to parse a string value that was expected to be a valid UUID format. "+
+						"The expected UUID format is 00000000-0000-0000-0000-000000000000. "+
+						"For example, a Version 4 UUID is of the form 7b16fd41-cc23-4ef7-8aa9-c598350ccd18.\n\n"+
+						"Provided Value: \"EB6F148A-6637-4C6B-A4BB-B75B2A1B5A3C \"\n"+
+						"Parse Error: stray whitespace: found whitespace at offset 36\n\n"+
+						"    EB6F148A-6637-4C6B-A4BB-B75B2A1B5A3C \n"+
+						"                                        ^\n\n"+
+						"Suggested Value: \"eb6f148a-6637-4c6b-a4bb-b75b2a1b5a3c\"",
+				),
+			},
+		},
+		{
+			name:     "invalid-non-ascii",
+			uuidType: uuidtypes.UUIDType{},
+			value:    "eb6f148a-6637-4c6b-a4bb-b75b2a1b5a3…",
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid UUID String Value",
+					"An unexpected error occurred attempting to parse a string value that was expected to be a valid UUID format. "+
+						"The expected UUID format is 00000000-0000-0000-0000-000000000000. "+
+						"For example, a Version 4 UUID is of the form 7b16fd41-cc23-4ef7-8aa9-c598350ccd18.\n\n"+
+						"Provided Value: \"eb6f148a-6637-4c6b-a4bb-b75b2a1b5a3…\"\n"+
+						"Parse Error: wrong length: expected 36 characters but got 38",
+				),
+			},
+		},
+		{
+			name:     "strict-valid",
+			uuidType: uuidtypes.UUIDType{Strict: true},
+			value:    valueUUIDv7,
+		},
+		{
+			name:     "strict-invalid",
+			uuidType: uuidtypes.UUIDType{Strict: true},
+			value:    valueUUIDv4VariantMicrosoft,
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid UUID String Value",
+					"An unexpected error occurred attempting to parse a string value that was expected to be a valid UUID format. "+
+						"The expected UUID format is 00000000-0000-0000-0000-000000000000. "+
+						"For example, a Version 4 UUID is of the form 7b16fd41-cc23-4ef7-8aa9-c598350ccd18.\n\n"+
+						"Provided Value: \"eb6f148a-6637-4c6b-c4bb-b75b2a1b5a3c\"\n"+
+						"Parse Error: invalid variant: expected the RFC 9562 variant but found the Microsoft variant at offset 19\n\n"+
+						"    eb6f148a-6637-4c6b-c4bb-b75b2a1b5a3c\n"+
+						"                       ^",
+				),
+			},
+		},
+		{
+			name:     "formats-braced",
+			uuidType: uuidtypes.UUIDType{Formats: uuidtypes.FormatCanonical | uuidtypes.FormatBraced},
+			value:    valueUUIDv4Braced,
+		},
+		{
+			name:     "formats-urn",
+			uuidType: uuidtypes.UUIDType{Formats: uuidtypes.FormatURN},
+			value:    valueUUIDv4URN,
+		},
+		{
+			name:     "formats-hex",
+			uuidType: uuidtypes.UUIDType{Formats: uuidtypes.FormatHex},
+			value:    valueUUIDv4Hex,
+		},
+		{
+			name:     "formats-base64url",
+			uuidType: uuidtypes.UUIDType{Formats: uuidtypes.FormatBase64URL},
+			value:    valueUUIDv4Base64URL,
+		},
+		{
+			name:     "formats-base32",
+			uuidType: uuidtypes.UUIDType{Formats: uuidtypes.FormatBase32},
+			value:    valueUUIDv4Base32,
+		},
+		{
+			name:     "formats-base64url-disallow-uppercase",
+			uuidType: uuidtypes.UUIDType{Formats: uuidtypes.FormatBase64URL, DisallowUppercase: true},
+			value:    valueUUIDv4Base64URL,
+		},
+		{
+			name:     "formats-base64url-not-accepted",
+			uuidType: uuidtypes.UUIDType{Formats: uuidtypes.FormatAll},
+			value:    valueUUIDv4Base64URL,
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid UUID String Value",
+					"An unexpected error occurred attempting to parse a string value that was expected to be a valid UUID format. "+
+						"The expected UUID format is 00000000-0000-0000-0000-000000000000. "+
+						"For example, a Version 4 UUID is of the form 7b16fd41-cc23-4ef7-8aa9-c598350ccd18.\n\n"+
+						"Provided Value: \"628UimY3TGuku7dbKhtaPA\"\n"+
+						"Parse Error: wrong length: expected 36 characters but got 22\n\n"+
+						"    628UimY3TGuku7dbKhtaPA\n"+
+						"                          ^",
+				),
+			},
+		},
+		{
+			name:     "formats-canonical-not-accepted",
+			uuidType: uuidtypes.UUIDType{Formats: uuidtypes.FormatBraced | uuidtypes.FormatHex},
+			value:    valueUUIDv4,
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid UUID String Value",
+					"An unexpected error occurred attempting to parse a string value that was expected to be a valid UUID format. "+
+						"The expected UUID format is 00000000-0000-0000-0000-000000000000. "+
+						"For example, a Version 4 UUID is of the form 7b16fd41-cc23-4ef7-8aa9-c598350ccd18.\n\n"+
+						"Provided Value: \"eb6f148a-6637-4c6b-a4bb-b75b2a1b5a3c\"\n"+
+						"Parse Error: unaccepted format: expected braced|hex\n\n"+
+						"    eb6f148a-6637-4c6b-a4bb-b75b2a1b5a3c\n"+
+						"    ^\n\n"+
+						"Suggested Value: \"eb6f148a-6637-4c6b-a4bb-b75b2a1b5a3c\"",
+				),
+			},
+		},
+		{
+			name:     "formats-braced-not-accepted",
+			uuidType: uuidtypes.UUIDType{},
+			value:    valueUUIDv4Braced,
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid UUID String Value",
+					"An unexpected error occurred attempting to parse a string value that was expected to be a valid UUID format. "+
+						"The expected UUID format is 00000000-0000-0000-0000-000000000000. "+
+						"For example, a Version 4 UUID is of the form 7b16fd41-cc23-4ef7-8aa9-c598350ccd18.\n\n"+
+						"Provided Value: \"{eb6f148a-6637-4c6b-a4bb-b75b2a1b5a3c}\"\n"+
+						"Parse Error: unexpected braces: found '{' at offset 0\n\n"+
+						"    {eb6f148a-6637-4c6b-a4bb-b75b2a1b5a3c}\n"+
+						"    ^\n\n"+
+						"Suggested Value: \"eb6f148a-6637-4c6b-a4bb-b75b2a1b5a3c\"",
+				),
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			value := tftypes.NewValue(tftypes.String, testcase.value)
+			got := testcase.uuidType.Validate(context.Background(), value, path.Root("test"))
+
+			if diff := cmp.Diff(got, testcase.expected); diff != "" {
+				t.Errorf(
+					"Validate()\ngot     : %s\nexpected: %s\ndiff    : %s",
+					got,
+					testcase.expected,
+					diff,
+				)
+			}
+		})
+	}
+}
+
+func TestUUIDType_ValueFromString_Policy(t *testing.T) {
+	t.Parallel()
+
+	uuidType := uuidtypes.UUIDType{Versions: uuidtypes.NewVersionSet(uuidtypes.Version4)}
+
+	got, diags := uuidType.ValueFromString(context.Background(), basetypes.NewStringValue(valueUUIDv4))
+	if diags.HasError() {
+		t.Fatalf("ValueFromString() unexpected diagnostics: %v", diags)
+	}
+
+	if diff := cmp.Diff(got.Type(context.Background()), uuidType); diff != "" {
+		t.Errorf("ValueFromString().Type()\ngot     : %v\nexpected: %v\ndiff: %v\n", got.Type(context.Background()), uuidType, diff)
+	}
+
+	if !got.Equal(uuidtypes.NewUUIDValue(valueUUIDv4)) {
+		t.Errorf("ValueFromString().Equal()\ngot     : false\nexpected: true")
+	}
+}
+
+func TestUUIDType_NewValue(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	uuidType := uuidtypes.UUIDType{Versions: uuidtypes.NewVersionSet(uuidtypes.Version4)}
+
+	tests := []struct {
+		name     string
+		value    uuidtypes.UUIDValue
+		expected uuidtypes.UUIDValue
+	}{
+		{
+			name:     "null",
+			value:    uuidType.NewNull(),
+			expected: uuidtypes.NewUUIDNull(),
+		},
+		{
+			name:     "unknown",
+			value:    uuidType.NewUnknown(),
+			expected: uuidtypes.NewUUIDUnknown(),
+		},
+		{
+			name:     "known",
+			value:    uuidType.NewValue(valueUUIDv4),
+			expected: uuidtypes.NewUUIDValue(valueUUIDv4),
+		},
+	}
+
+	for _, testcase := range tests {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if !testcase.value.Equal(testcase.expected) {
+				t.Errorf("Equal()\ngot     : %v\nexpected: %v\n", testcase.value, testcase.expected)
+			}
+
+			if got := testcase.value.Type(ctx); got != uuidType {
+				t.Errorf("Type()\ngot     : %v\nexpected: %v\n", got, uuidType)
+			}
+
+			// The value is usable as an element of a collection of the type.
+			if _, diags := basetypes.NewListValue(uuidType, []attr.Value{testcase.value}); diags.HasError() {
+				t.Errorf("NewListValue() unexpected diagnostics: %v", diags)
+			}
+		})
+	}
+}
+
+func TestUUIDType_ValueFromString_StrictConversion(t *testing.T) {
+	t.Parallel()
+
+	uuidType := uuidtypes.UUIDType{
+		Versions:         uuidtypes.NewVersionSet(uuidtypes.Version4),
+		Formats:          uuidtypes.FormatAll,
+		StrictConversion: true,
+	}
+
+	tests := []struct {
+		name          string
+		value         basetypes.StringValue
+		expected      basetypes.StringValue
+		expectedDiags diag.Diagnostics
+	}{
+		{
+			name:     "null",
+			value:    basetypes.NewStringNull(),
+			expected: basetypes.NewStringNull(),
+		},
+		{
+			name:     "unknown",
+			value:    basetypes.NewStringUnknown(),
+			expected: basetypes.NewStringUnknown(),
+		},
+		{
+			name:     "canonical",
+			value:    basetypes.NewStringValue(valueUUIDv4),
+			expected: basetypes.NewStringValue(valueUUIDv4),
+		},
+		{
+			name:     "urn-uppercase-canonicalized",
+			value:    basetypes.NewStringValue("urn:uuid:" + valueUUIDv4Upper),
+			expected: basetypes.NewStringValue(valueUUIDv4),
+		},
+		{
+			name:     "invalid-unchanged",
+			value:    basetypes.NewStringValue(valueInvalidLength),
+			expected: basetypes.NewStringValue(valueInvalidLength),
+		},
+		{
+			name:     "policy-violation-unchanged",
+			value:    basetypes.NewStringValue("urn:uuid:" + valueUUIDv1),
+			expected: basetypes.NewStringValue("urn:uuid:" + valueUUIDv1),
+		},
+	}
+
+	for _, testcase := range tests {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, gotDiags := uuidType.ValueFromString(context.Background(), testcase.value)
+
+			if got != nil {
+				if diff := cmp.Diff(got.Type(context.Background()), uuidType); diff != "" {
+					t.Errorf("ValueFromString().Type()\ngot     : %v\nexpected: %v\ndiff    : %s\n", got.Type(context.Background()), uuidType, diff)
+				}
+
+				gotString, _ := got.ToStringValue(context.Background())
+				if diff := cmp.Diff(gotString, testcase.expected); diff != "" {
+					t.Errorf("ValueFromString()\ngot     : %v\nexpected: %v\ndiff    : %s\n", gotString, testcase.expected, diff)
+				}
+			} else if !testcase.expectedDiags.HasError() {
+				t.Errorf("ValueFromString()\ngot     : <nil>\nexpected: %v\n", testcase.expected)
+			}
+
+			if diff := cmp.Diff(gotDiags, testcase.expectedDiags); diff != "" {
+				t.Errorf("ValueFromString() diag.Diagnostics\ngot     : %v\nexpected: %v\ndiff    : %s\n", gotDiags, testcase.expectedDiags, diff)
+			}
+		})
+	}
+}
+
+func TestUUIDType_ValueFromTerraform_StrictConversion(t *testing.T) {
+	t.Parallel()
+
+	uuidType := uuidtypes.UUIDType{StrictConversion: true}
+
+	got, err := uuidType.ValueFromTerraform(context.Background(), tftypes.NewValue(tftypes.String, valueUUIDv4Upper))
+	if err != nil {
+		t.Fatalf("ValueFromTerraform() unexpected error: %v", err)
+	}
+
+	expected := uuidValueFromType(uuidType, valueUUIDv4)
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("ValueFromTerraform()\ngot     : %v\nexpected: %v\ndiff    : %s\n", got, expected, diff)
+	}
+
+	got, err = uuidType.ValueFromTerraform(context.Background(), tftypes.NewValue(tftypes.String, valueInvalid))
+	if err != nil {
+		t.Fatalf("ValueFromTerraform() unexpected error: %v", err)
+	}
+
+	expected = uuidValueFromType(uuidType, valueInvalid)
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("ValueFromTerraform()\ngot     : %v\nexpected: %v\ndiff    : %s\n", got, expected, diff)
+	}
+}
+
+func TestUUIDType_StrictConversion_Config(t *testing.T) {
+	t.Parallel()
+
+	uuidType := uuidtypes.UUIDType{
+		DisallowUppercase: true,
+		StrictConversion:  true,
+	}
+
+	config := tfsdk.Config{
+		Schema: schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					CustomType: uuidType,
+					Required:   true,
+				},
+			},
+		},
+		Raw: tftypes.NewValue(tftypes.Object{
+			AttributeTypes: map[string]tftypes.Type{
+				"id": tftypes.String,
+			},
+		}, map[string]tftypes.Value{
+			"id": tftypes.NewValue(tftypes.String, " "+valueUUIDv4),
+		}),
+	}
+
+	var got uuidtypes.UUIDValue
+	gotDiags := config.GetAttribute(context.Background(), path.Root("id"), &got)
+
+	expectedDiags := diag.Diagnostics{
+		diag.NewAttributeErrorDiagnostic(
+			path.Root("id"),
+			"Invalid UUID String Value",
+			"An unexpected error occurred attempting to parse a string value that was expected to be a valid UUID format. "+
+				"The expected UUID format is 00000000-0000-0000-0000-000000000000. "+
+				"For example, a Version 4 UUID is of the form 7b16fd41-cc23-4ef7-8aa9-c598350ccd18.\n\n"+
+				"Provided Value: \" eb6f148a-6637-4c6b-a4bb-b75b2a1b5a3c\"\n"+
+				"Parse Error: stray whitespace: found whitespace at offset 0\n\n"+
+				"     eb6f148a-6637-4c6b-a4bb-b75b2a1b5a3c\n"+
+				"    ^\n\n"+
+				"Suggested Value: \"eb6f148a-6637-4c6b-a4bb-b75b2a1b5a3c\"",
+		),
+	}
+	if diff := cmp.Diff(gotDiags, expectedDiags); diff != "" {
+		t.Errorf("GetAttribute() diag.Diagnostics\ngot     : %v\nexpected: %v\ndiff    : %s\n", gotDiags, expectedDiags, diff)
+	}
+}
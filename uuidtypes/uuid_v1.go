@@ -0,0 +1,147 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidtypes
+
+import (
+	// Standard Library Imports
+	"context"
+	"fmt"
+
+	// External Imports
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure Implementation matches the expected interfaces.
+var (
+	_ attr.Type                                  = UUIDv1Type{}
+	_ basetypes.StringTypable                    = UUIDv1Type{}
+	_ xattr.TypeWithValidate                     = UUIDv1Type{}
+	_ attr.Value                                 = UUIDv1Value{}
+	_ basetypes.StringValuableWithSemanticEquals = UUIDv1Value{}
+	_ function.ValidateableParameter             = UUIDv1Value{}
+)
+
+// UUIDv1Type is a StringType which only accepts Version 1, Gregorian time-based, UUIDs.
+type UUIDv1Type struct {
+	basetypes.StringType
+}
+
+// Equal returns true if the two types are equal.
+func (u UUIDv1Type) Equal(o attr.Type) bool {
+	_, ok := o.(UUIDv1Type)
+
+	return ok
+}
+
+// String returns a human-friendly version of the Type.
+func (u UUIDv1Type) String() string {
+	return "uuidtypes.UUIDv1Type"
+}
+
+// Validate ensures the value is a valid Version 1 UUID.
+func (u UUIDv1Type) Validate(ctx context.Context, value tftypes.Value, schemaPath path.Path) diag.Diagnostics {
+	return versionType(Version1).Validate(ctx, value, schemaPath)
+}
+
+// ValueFromString converts a string value to a StringValuable.
+func (u UUIDv1Type) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return newUUIDv1Value(in), nil
+}
+
+// ValueFromTerraform returns a UUIDv1Value value given a tftypes.Value.
+func (u UUIDv1Type) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	return valueFromTerraform(ctx, u, in)
+}
+
+// ValueType returns attr.Value type returned by ValueFromTerraform.
+func (u UUIDv1Type) ValueType(context.Context) attr.Value {
+	return newUUIDv1Value(basetypes.StringValue{})
+}
+
+// UUIDv1Value provides a concrete implementation of a Version 1 UUID
+// tftypes.Value for the Terraform Plugin framework. The embedded UUIDValue
+// enforces the Version 1 policy, so its accessors, such as ValueUUID, and its
+// validation only accept Version 1 UUIDs.
+type UUIDv1Value struct {
+	UUIDValue
+}
+
+// Type returns the UUIDv1Type type that created the UUIDv1Value.
+func (u UUIDv1Value) Type(_ context.Context) attr.Type {
+	return UUIDv1Type{}
+}
+
+// Equal returns true if the uuid is equal to the Value passed as an argument.
+func (u UUIDv1Value) Equal(o attr.Value) bool {
+	other, ok := o.(UUIDv1Value)
+	if !ok {
+		return false
+	}
+
+	return u.UUIDValue.Equal(other.UUIDValue)
+}
+
+// StringSemanticEquals returns true if the given UUID value is semantically
+// equal to the current UUID value.
+func (u UUIDv1Value) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(UUIDv1Value)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				fmt.Sprintf("Expected Value Type: %T\n", u)+
+				fmt.Sprintf("Got Value Type: %T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	return u.UUIDValue.StringSemanticEquals(ctx, newValue.UUIDValue)
+}
+
+// NewUUIDv1Null creates a Version 1 UUID with a null value.
+func NewUUIDv1Null() UUIDv1Value {
+	return newUUIDv1Value(basetypes.NewStringNull())
+}
+
+// NewUUIDv1Unknown creates a Version 1 UUID with an unknown value.
+func NewUUIDv1Unknown() UUIDv1Value {
+	return newUUIDv1Value(basetypes.NewStringUnknown())
+}
+
+// NewUUIDv1Value creates a Version 1 UUID with a known value.
+func NewUUIDv1Value(value string) UUIDv1Value {
+	return newUUIDv1Value(basetypes.NewStringValue(value))
+}
+
+// NewUUIDv1PointerValue creates a Version 1 UUID with a null value if nil or a
+// known value.
+func NewUUIDv1PointerValue(value *string) UUIDv1Value {
+	return newUUIDv1Value(basetypes.NewStringPointerValue(value))
+}
+
+// newUUIDv1Value returns the UUIDv1Value of the string value, enforcing the
+// Version 1 policy.
+func newUUIDv1Value(value basetypes.StringValue) UUIDv1Value {
+	return UUIDv1Value{
+		UUIDValue: UUIDValue{
+			StringValue: value,
+			uuidType:    versionType(Version1),
+		},
+	}
+}
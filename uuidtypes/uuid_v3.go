@@ -0,0 +1,147 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidtypes
+
+import (
+	// Standard Library Imports
+	"context"
+	"fmt"
+
+	// External Imports
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure Implementation matches the expected interfaces.
+var (
+	_ attr.Type                                  = UUIDv3Type{}
+	_ basetypes.StringTypable                    = UUIDv3Type{}
+	_ xattr.TypeWithValidate                     = UUIDv3Type{}
+	_ attr.Value                                 = UUIDv3Value{}
+	_ basetypes.StringValuableWithSemanticEquals = UUIDv3Value{}
+	_ function.ValidateableParameter             = UUIDv3Value{}
+)
+
+// UUIDv3Type is a StringType which only accepts Version 3, MD5 name-based, UUIDs.
+type UUIDv3Type struct {
+	basetypes.StringType
+}
+
+// Equal returns true if the two types are equal.
+func (u UUIDv3Type) Equal(o attr.Type) bool {
+	_, ok := o.(UUIDv3Type)
+
+	return ok
+}
+
+// String returns a human-friendly version of the Type.
+func (u UUIDv3Type) String() string {
+	return "uuidtypes.UUIDv3Type"
+}
+
+// Validate ensures the value is a valid Version 3 UUID.
+func (u UUIDv3Type) Validate(ctx context.Context, value tftypes.Value, schemaPath path.Path) diag.Diagnostics {
+	return versionType(Version3).Validate(ctx, value, schemaPath)
+}
+
+// ValueFromString converts a string value to a StringValuable.
+func (u UUIDv3Type) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return newUUIDv3Value(in), nil
+}
+
+// ValueFromTerraform returns a UUIDv3Value value given a tftypes.Value.
+func (u UUIDv3Type) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	return valueFromTerraform(ctx, u, in)
+}
+
+// ValueType returns attr.Value type returned by ValueFromTerraform.
+func (u UUIDv3Type) ValueType(context.Context) attr.Value {
+	return newUUIDv3Value(basetypes.StringValue{})
+}
+
+// UUIDv3Value provides a concrete implementation of a Version 3 UUID
+// tftypes.Value for the Terraform Plugin framework. The embedded UUIDValue
+// enforces the Version 3 policy, so its accessors, such as ValueUUID, and its
+// validation only accept Version 3 UUIDs.
+type UUIDv3Value struct {
+	UUIDValue
+}
+
+// Type returns the UUIDv3Type type that created the UUIDv3Value.
+func (u UUIDv3Value) Type(_ context.Context) attr.Type {
+	return UUIDv3Type{}
+}
+
+// Equal returns true if the uuid is equal to the Value passed as an argument.
+func (u UUIDv3Value) Equal(o attr.Value) bool {
+	other, ok := o.(UUIDv3Value)
+	if !ok {
+		return false
+	}
+
+	return u.UUIDValue.Equal(other.UUIDValue)
+}
+
+// StringSemanticEquals returns true if the given UUID value is semantically
+// equal to the current UUID value.
+func (u UUIDv3Value) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(UUIDv3Value)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				fmt.Sprintf("Expected Value Type: %T\n", u)+
+				fmt.Sprintf("Got Value Type: %T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	return u.UUIDValue.StringSemanticEquals(ctx, newValue.UUIDValue)
+}
+
+// NewUUIDv3Null creates a Version 3 UUID with a null value.
+func NewUUIDv3Null() UUIDv3Value {
+	return newUUIDv3Value(basetypes.NewStringNull())
+}
+
+// NewUUIDv3Unknown creates a Version 3 UUID with an unknown value.
+func NewUUIDv3Unknown() UUIDv3Value {
+	return newUUIDv3Value(basetypes.NewStringUnknown())
+}
+
+// NewUUIDv3Value creates a Version 3 UUID with a known value.
+func NewUUIDv3Value(value string) UUIDv3Value {
+	return newUUIDv3Value(basetypes.NewStringValue(value))
+}
+
+// NewUUIDv3PointerValue creates a Version 3 UUID with a null value if nil or a
+// known value.
+func NewUUIDv3PointerValue(value *string) UUIDv3Value {
+	return newUUIDv3Value(basetypes.NewStringPointerValue(value))
+}
+
+// newUUIDv3Value returns the UUIDv3Value of the string value, enforcing the
+// Version 3 policy.
+func newUUIDv3Value(value basetypes.StringValue) UUIDv3Value {
+	return UUIDv3Value{
+		UUIDValue: UUIDValue{
+			StringValue: value,
+			uuidType:    versionType(Version3),
+		},
+	}
+}
@@ -0,0 +1,147 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidtypes
+
+import (
+	// Standard Library Imports
+	"context"
+	"fmt"
+
+	// External Imports
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure Implementation matches the expected interfaces.
+var (
+	_ attr.Type                                  = UUIDv4Type{}
+	_ basetypes.StringTypable                    = UUIDv4Type{}
+	_ xattr.TypeWithValidate                     = UUIDv4Type{}
+	_ attr.Value                                 = UUIDv4Value{}
+	_ basetypes.StringValuableWithSemanticEquals = UUIDv4Value{}
+	_ function.ValidateableParameter             = UUIDv4Value{}
+)
+
+// UUIDv4Type is a StringType which only accepts Version 4, randomly generated, UUIDs.
+type UUIDv4Type struct {
+	basetypes.StringType
+}
+
+// Equal returns true if the two types are equal.
+func (u UUIDv4Type) Equal(o attr.Type) bool {
+	_, ok := o.(UUIDv4Type)
+
+	return ok
+}
+
+// String returns a human-friendly version of the Type.
+func (u UUIDv4Type) String() string {
+	return "uuidtypes.UUIDv4Type"
+}
+
+// Validate ensures the value is a valid Version 4 UUID.
+func (u UUIDv4Type) Validate(ctx context.Context, value tftypes.Value, schemaPath path.Path) diag.Diagnostics {
+	return versionType(Version4).Validate(ctx, value, schemaPath)
+}
+
+// ValueFromString converts a string value to a StringValuable.
+func (u UUIDv4Type) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return newUUIDv4Value(in), nil
+}
+
+// ValueFromTerraform returns a UUIDv4Value value given a tftypes.Value.
+func (u UUIDv4Type) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	return valueFromTerraform(ctx, u, in)
+}
+
+// ValueType returns attr.Value type returned by ValueFromTerraform.
+func (u UUIDv4Type) ValueType(context.Context) attr.Value {
+	return newUUIDv4Value(basetypes.StringValue{})
+}
+
+// UUIDv4Value provides a concrete implementation of a Version 4 UUID
+// tftypes.Value for the Terraform Plugin framework. The embedded UUIDValue
+// enforces the Version 4 policy, so its accessors, such as ValueUUID, and its
+// validation only accept Version 4 UUIDs.
+type UUIDv4Value struct {
+	UUIDValue
+}
+
+// Type returns the UUIDv4Type type that created the UUIDv4Value.
+func (u UUIDv4Value) Type(_ context.Context) attr.Type {
+	return UUIDv4Type{}
+}
+
+// Equal returns true if the uuid is equal to the Value passed as an argument.
+func (u UUIDv4Value) Equal(o attr.Value) bool {
+	other, ok := o.(UUIDv4Value)
+	if !ok {
+		return false
+	}
+
+	return u.UUIDValue.Equal(other.UUIDValue)
+}
+
+// StringSemanticEquals returns true if the given UUID value is semantically
+// equal to the current UUID value.
+func (u UUIDv4Value) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(UUIDv4Value)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				fmt.Sprintf("Expected Value Type: %T\n", u)+
+				fmt.Sprintf("Got Value Type: %T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	return u.UUIDValue.StringSemanticEquals(ctx, newValue.UUIDValue)
+}
+
+// NewUUIDv4Null creates a Version 4 UUID with a null value.
+func NewUUIDv4Null() UUIDv4Value {
+	return newUUIDv4Value(basetypes.NewStringNull())
+}
+
+// NewUUIDv4Unknown creates a Version 4 UUID with an unknown value.
+func NewUUIDv4Unknown() UUIDv4Value {
+	return newUUIDv4Value(basetypes.NewStringUnknown())
+}
+
+// NewUUIDv4Value creates a Version 4 UUID with a known value.
+func NewUUIDv4Value(value string) UUIDv4Value {
+	return newUUIDv4Value(basetypes.NewStringValue(value))
+}
+
+// NewUUIDv4PointerValue creates a Version 4 UUID with a null value if nil or a
+// known value.
+func NewUUIDv4PointerValue(value *string) UUIDv4Value {
+	return newUUIDv4Value(basetypes.NewStringPointerValue(value))
+}
+
+// newUUIDv4Value returns the UUIDv4Value of the string value, enforcing the
+// Version 4 policy.
+func newUUIDv4Value(value basetypes.StringValue) UUIDv4Value {
+	return UUIDv4Value{
+		UUIDValue: UUIDValue{
+			StringValue: value,
+			uuidType:    versionType(Version4),
+		},
+	}
+}
@@ -0,0 +1,147 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidtypes
+
+import (
+	// Standard Library Imports
+	"context"
+	"fmt"
+
+	// External Imports
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure Implementation matches the expected interfaces.
+var (
+	_ attr.Type                                  = UUIDv5Type{}
+	_ basetypes.StringTypable                    = UUIDv5Type{}
+	_ xattr.TypeWithValidate                     = UUIDv5Type{}
+	_ attr.Value                                 = UUIDv5Value{}
+	_ basetypes.StringValuableWithSemanticEquals = UUIDv5Value{}
+	_ function.ValidateableParameter             = UUIDv5Value{}
+)
+
+// UUIDv5Type is a StringType which only accepts Version 5, SHA-1 name-based, UUIDs.
+type UUIDv5Type struct {
+	basetypes.StringType
+}
+
+// Equal returns true if the two types are equal.
+func (u UUIDv5Type) Equal(o attr.Type) bool {
+	_, ok := o.(UUIDv5Type)
+
+	return ok
+}
+
+// String returns a human-friendly version of the Type.
+func (u UUIDv5Type) String() string {
+	return "uuidtypes.UUIDv5Type"
+}
+
+// Validate ensures the value is a valid Version 5 UUID.
+func (u UUIDv5Type) Validate(ctx context.Context, value tftypes.Value, schemaPath path.Path) diag.Diagnostics {
+	return versionType(Version5).Validate(ctx, value, schemaPath)
+}
+
+// ValueFromString converts a string value to a StringValuable.
+func (u UUIDv5Type) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return newUUIDv5Value(in), nil
+}
+
+// ValueFromTerraform returns a UUIDv5Value value given a tftypes.Value.
+func (u UUIDv5Type) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	return valueFromTerraform(ctx, u, in)
+}
+
+// ValueType returns attr.Value type returned by ValueFromTerraform.
+func (u UUIDv5Type) ValueType(context.Context) attr.Value {
+	return newUUIDv5Value(basetypes.StringValue{})
+}
+
+// UUIDv5Value provides a concrete implementation of a Version 5 UUID
+// tftypes.Value for the Terraform Plugin framework. The embedded UUIDValue
+// enforces the Version 5 policy, so its accessors, such as ValueUUID, and its
+// validation only accept Version 5 UUIDs.
+type UUIDv5Value struct {
+	UUIDValue
+}
+
+// Type returns the UUIDv5Type type that created the UUIDv5Value.
+func (u UUIDv5Value) Type(_ context.Context) attr.Type {
+	return UUIDv5Type{}
+}
+
+// Equal returns true if the uuid is equal to the Value passed as an argument.
+func (u UUIDv5Value) Equal(o attr.Value) bool {
+	other, ok := o.(UUIDv5Value)
+	if !ok {
+		return false
+	}
+
+	return u.UUIDValue.Equal(other.UUIDValue)
+}
+
+// StringSemanticEquals returns true if the given UUID value is semantically
+// equal to the current UUID value.
+func (u UUIDv5Value) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(UUIDv5Value)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				fmt.Sprintf("Expected Value Type: %T\n", u)+
+				fmt.Sprintf("Got Value Type: %T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	return u.UUIDValue.StringSemanticEquals(ctx, newValue.UUIDValue)
+}
+
+// NewUUIDv5Null creates a Version 5 UUID with a null value.
+func NewUUIDv5Null() UUIDv5Value {
+	return newUUIDv5Value(basetypes.NewStringNull())
+}
+
+// NewUUIDv5Unknown creates a Version 5 UUID with an unknown value.
+func NewUUIDv5Unknown() UUIDv5Value {
+	return newUUIDv5Value(basetypes.NewStringUnknown())
+}
+
+// NewUUIDv5Value creates a Version 5 UUID with a known value.
+func NewUUIDv5Value(value string) UUIDv5Value {
+	return newUUIDv5Value(basetypes.NewStringValue(value))
+}
+
+// NewUUIDv5PointerValue creates a Version 5 UUID with a null value if nil or a
+// known value.
+func NewUUIDv5PointerValue(value *string) UUIDv5Value {
+	return newUUIDv5Value(basetypes.NewStringPointerValue(value))
+}
+
+// newUUIDv5Value returns the UUIDv5Value of the string value, enforcing the
+// Version 5 policy.
+func newUUIDv5Value(value basetypes.StringValue) UUIDv5Value {
+	return UUIDv5Value{
+		UUIDValue: UUIDValue{
+			StringValue: value,
+			uuidType:    versionType(Version5),
+		},
+	}
+}
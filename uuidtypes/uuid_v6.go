@@ -0,0 +1,147 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidtypes
+
+import (
+	// Standard Library Imports
+	"context"
+	"fmt"
+
+	// External Imports
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure Implementation matches the expected interfaces.
+var (
+	_ attr.Type                                  = UUIDv6Type{}
+	_ basetypes.StringTypable                    = UUIDv6Type{}
+	_ xattr.TypeWithValidate                     = UUIDv6Type{}
+	_ attr.Value                                 = UUIDv6Value{}
+	_ basetypes.StringValuableWithSemanticEquals = UUIDv6Value{}
+	_ function.ValidateableParameter             = UUIDv6Value{}
+)
+
+// UUIDv6Type is a StringType which only accepts Version 6, reordered Gregorian time-based, UUIDs.
+type UUIDv6Type struct {
+	basetypes.StringType
+}
+
+// Equal returns true if the two types are equal.
+func (u UUIDv6Type) Equal(o attr.Type) bool {
+	_, ok := o.(UUIDv6Type)
+
+	return ok
+}
+
+// String returns a human-friendly version of the Type.
+func (u UUIDv6Type) String() string {
+	return "uuidtypes.UUIDv6Type"
+}
+
+// Validate ensures the value is a valid Version 6 UUID.
+func (u UUIDv6Type) Validate(ctx context.Context, value tftypes.Value, schemaPath path.Path) diag.Diagnostics {
+	return versionType(Version6).Validate(ctx, value, schemaPath)
+}
+
+// ValueFromString converts a string value to a StringValuable.
+func (u UUIDv6Type) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return newUUIDv6Value(in), nil
+}
+
+// ValueFromTerraform returns a UUIDv6Value value given a tftypes.Value.
+func (u UUIDv6Type) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	return valueFromTerraform(ctx, u, in)
+}
+
+// ValueType returns attr.Value type returned by ValueFromTerraform.
+func (u UUIDv6Type) ValueType(context.Context) attr.Value {
+	return newUUIDv6Value(basetypes.StringValue{})
+}
+
+// UUIDv6Value provides a concrete implementation of a Version 6 UUID
+// tftypes.Value for the Terraform Plugin framework. The embedded UUIDValue
+// enforces the Version 6 policy, so its accessors, such as ValueUUID, and its
+// validation only accept Version 6 UUIDs.
+type UUIDv6Value struct {
+	UUIDValue
+}
+
+// Type returns the UUIDv6Type type that created the UUIDv6Value.
+func (u UUIDv6Value) Type(_ context.Context) attr.Type {
+	return UUIDv6Type{}
+}
+
+// Equal returns true if the uuid is equal to the Value passed as an argument.
+func (u UUIDv6Value) Equal(o attr.Value) bool {
+	other, ok := o.(UUIDv6Value)
+	if !ok {
+		return false
+	}
+
+	return u.UUIDValue.Equal(other.UUIDValue)
+}
+
+// StringSemanticEquals returns true if the given UUID value is semantically
+// equal to the current UUID value.
+func (u UUIDv6Value) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(UUIDv6Value)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				fmt.Sprintf("Expected Value Type: %T\n", u)+
+				fmt.Sprintf("Got Value Type: %T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	return u.UUIDValue.StringSemanticEquals(ctx, newValue.UUIDValue)
+}
+
+// NewUUIDv6Null creates a Version 6 UUID with a null value.
+func NewUUIDv6Null() UUIDv6Value {
+	return newUUIDv6Value(basetypes.NewStringNull())
+}
+
+// NewUUIDv6Unknown creates a Version 6 UUID with an unknown value.
+func NewUUIDv6Unknown() UUIDv6Value {
+	return newUUIDv6Value(basetypes.NewStringUnknown())
+}
+
+// NewUUIDv6Value creates a Version 6 UUID with a known value.
+func NewUUIDv6Value(value string) UUIDv6Value {
+	return newUUIDv6Value(basetypes.NewStringValue(value))
+}
+
+// NewUUIDv6PointerValue creates a Version 6 UUID with a null value if nil or a
+// known value.
+func NewUUIDv6PointerValue(value *string) UUIDv6Value {
+	return newUUIDv6Value(basetypes.NewStringPointerValue(value))
+}
+
+// newUUIDv6Value returns the UUIDv6Value of the string value, enforcing the
+// Version 6 policy.
+func newUUIDv6Value(value basetypes.StringValue) UUIDv6Value {
+	return UUIDv6Value{
+		UUIDValue: UUIDValue{
+			StringValue: value,
+			uuidType:    versionType(Version6),
+		},
+	}
+}
@@ -0,0 +1,147 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidtypes
+
+import (
+	// Standard Library Imports
+	"context"
+	"fmt"
+
+	// External Imports
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure Implementation matches the expected interfaces.
+var (
+	_ attr.Type                                  = UUIDv7Type{}
+	_ basetypes.StringTypable                    = UUIDv7Type{}
+	_ xattr.TypeWithValidate                     = UUIDv7Type{}
+	_ attr.Value                                 = UUIDv7Value{}
+	_ basetypes.StringValuableWithSemanticEquals = UUIDv7Value{}
+	_ function.ValidateableParameter             = UUIDv7Value{}
+)
+
+// UUIDv7Type is a StringType which only accepts Version 7, Unix Epoch time-based, UUIDs.
+type UUIDv7Type struct {
+	basetypes.StringType
+}
+
+// Equal returns true if the two types are equal.
+func (u UUIDv7Type) Equal(o attr.Type) bool {
+	_, ok := o.(UUIDv7Type)
+
+	return ok
+}
+
+// String returns a human-friendly version of the Type.
+func (u UUIDv7Type) String() string {
+	return "uuidtypes.UUIDv7Type"
+}
+
+// Validate ensures the value is a valid Version 7 UUID.
+func (u UUIDv7Type) Validate(ctx context.Context, value tftypes.Value, schemaPath path.Path) diag.Diagnostics {
+	return versionType(Version7).Validate(ctx, value, schemaPath)
+}
+
+// ValueFromString converts a string value to a StringValuable.
+func (u UUIDv7Type) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return newUUIDv7Value(in), nil
+}
+
+// ValueFromTerraform returns a UUIDv7Value value given a tftypes.Value.
+func (u UUIDv7Type) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	return valueFromTerraform(ctx, u, in)
+}
+
+// ValueType returns attr.Value type returned by ValueFromTerraform.
+func (u UUIDv7Type) ValueType(context.Context) attr.Value {
+	return newUUIDv7Value(basetypes.StringValue{})
+}
+
+// UUIDv7Value provides a concrete implementation of a Version 7 UUID
+// tftypes.Value for the Terraform Plugin framework. The embedded UUIDValue
+// enforces the Version 7 policy, so its accessors, such as ValueUUID, and its
+// validation only accept Version 7 UUIDs.
+type UUIDv7Value struct {
+	UUIDValue
+}
+
+// Type returns the UUIDv7Type type that created the UUIDv7Value.
+func (u UUIDv7Value) Type(_ context.Context) attr.Type {
+	return UUIDv7Type{}
+}
+
+// Equal returns true if the uuid is equal to the Value passed as an argument.
+func (u UUIDv7Value) Equal(o attr.Value) bool {
+	other, ok := o.(UUIDv7Value)
+	if !ok {
+		return false
+	}
+
+	return u.UUIDValue.Equal(other.UUIDValue)
+}
+
+// StringSemanticEquals returns true if the given UUID value is semantically
+// equal to the current UUID value.
+func (u UUIDv7Value) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(UUIDv7Value)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				fmt.Sprintf("Expected Value Type: %T\n", u)+
+				fmt.Sprintf("Got Value Type: %T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	return u.UUIDValue.StringSemanticEquals(ctx, newValue.UUIDValue)
+}
+
+// NewUUIDv7Null creates a Version 7 UUID with a null value.
+func NewUUIDv7Null() UUIDv7Value {
+	return newUUIDv7Value(basetypes.NewStringNull())
+}
+
+// NewUUIDv7Unknown creates a Version 7 UUID with an unknown value.
+func NewUUIDv7Unknown() UUIDv7Value {
+	return newUUIDv7Value(basetypes.NewStringUnknown())
+}
+
+// NewUUIDv7Value creates a Version 7 UUID with a known value.
+func NewUUIDv7Value(value string) UUIDv7Value {
+	return newUUIDv7Value(basetypes.NewStringValue(value))
+}
+
+// NewUUIDv7PointerValue creates a Version 7 UUID with a null value if nil or a
+// known value.
+func NewUUIDv7PointerValue(value *string) UUIDv7Value {
+	return newUUIDv7Value(basetypes.NewStringPointerValue(value))
+}
+
+// newUUIDv7Value returns the UUIDv7Value of the string value, enforcing the
+// Version 7 policy.
+func newUUIDv7Value(value basetypes.StringValue) UUIDv7Value {
+	return UUIDv7Value{
+		UUIDValue: UUIDValue{
+			StringValue: value,
+			uuidType:    versionType(Version7),
+		},
+	}
+}
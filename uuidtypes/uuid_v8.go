@@ -0,0 +1,147 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidtypes
+
+import (
+	// Standard Library Imports
+	"context"
+	"fmt"
+
+	// External Imports
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure Implementation matches the expected interfaces.
+var (
+	_ attr.Type                                  = UUIDv8Type{}
+	_ basetypes.StringTypable                    = UUIDv8Type{}
+	_ xattr.TypeWithValidate                     = UUIDv8Type{}
+	_ attr.Value                                 = UUIDv8Value{}
+	_ basetypes.StringValuableWithSemanticEquals = UUIDv8Value{}
+	_ function.ValidateableParameter             = UUIDv8Value{}
+)
+
+// UUIDv8Type is a StringType which only accepts Version 8, custom vendor-specific, UUIDs.
+type UUIDv8Type struct {
+	basetypes.StringType
+}
+
+// Equal returns true if the two types are equal.
+func (u UUIDv8Type) Equal(o attr.Type) bool {
+	_, ok := o.(UUIDv8Type)
+
+	return ok
+}
+
+// String returns a human-friendly version of the Type.
+func (u UUIDv8Type) String() string {
+	return "uuidtypes.UUIDv8Type"
+}
+
+// Validate ensures the value is a valid Version 8 UUID.
+func (u UUIDv8Type) Validate(ctx context.Context, value tftypes.Value, schemaPath path.Path) diag.Diagnostics {
+	return versionType(Version8).Validate(ctx, value, schemaPath)
+}
+
+// ValueFromString converts a string value to a StringValuable.
+func (u UUIDv8Type) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return newUUIDv8Value(in), nil
+}
+
+// ValueFromTerraform returns a UUIDv8Value value given a tftypes.Value.
+func (u UUIDv8Type) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	return valueFromTerraform(ctx, u, in)
+}
+
+// ValueType returns attr.Value type returned by ValueFromTerraform.
+func (u UUIDv8Type) ValueType(context.Context) attr.Value {
+	return newUUIDv8Value(basetypes.StringValue{})
+}
+
+// UUIDv8Value provides a concrete implementation of a Version 8 UUID
+// tftypes.Value for the Terraform Plugin framework. The embedded UUIDValue
+// enforces the Version 8 policy, so its accessors, such as ValueUUID, and its
+// validation only accept Version 8 UUIDs.
+type UUIDv8Value struct {
+	UUIDValue
+}
+
+// Type returns the UUIDv8Type type that created the UUIDv8Value.
+func (u UUIDv8Value) Type(_ context.Context) attr.Type {
+	return UUIDv8Type{}
+}
+
+// Equal returns true if the uuid is equal to the Value passed as an argument.
+func (u UUIDv8Value) Equal(o attr.Value) bool {
+	other, ok := o.(UUIDv8Value)
+	if !ok {
+		return false
+	}
+
+	return u.UUIDValue.Equal(other.UUIDValue)
+}
+
+// StringSemanticEquals returns true if the given UUID value is semantically
+// equal to the current UUID value.
+func (u UUIDv8Value) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(UUIDv8Value)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				fmt.Sprintf("Expected Value Type: %T\n", u)+
+				fmt.Sprintf("Got Value Type: %T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	return u.UUIDValue.StringSemanticEquals(ctx, newValue.UUIDValue)
+}
+
+// NewUUIDv8Null creates a Version 8 UUID with a null value.
+func NewUUIDv8Null() UUIDv8Value {
+	return newUUIDv8Value(basetypes.NewStringNull())
+}
+
+// NewUUIDv8Unknown creates a Version 8 UUID with an unknown value.
+func NewUUIDv8Unknown() UUIDv8Value {
+	return newUUIDv8Value(basetypes.NewStringUnknown())
+}
+
+// NewUUIDv8Value creates a Version 8 UUID with a known value.
+func NewUUIDv8Value(value string) UUIDv8Value {
+	return newUUIDv8Value(basetypes.NewStringValue(value))
+}
+
+// NewUUIDv8PointerValue creates a Version 8 UUID with a null value if nil or a
+// known value.
+func NewUUIDv8PointerValue(value *string) UUIDv8Value {
+	return newUUIDv8Value(basetypes.NewStringPointerValue(value))
+}
+
+// newUUIDv8Value returns the UUIDv8Value of the string value, enforcing the
+// Version 8 policy.
+func newUUIDv8Value(value basetypes.StringValue) UUIDv8Value {
+	return UUIDv8Value{
+		UUIDValue: UUIDValue{
+			StringValue: value,
+			uuidType:    versionType(Version8),
+		},
+	}
+}
@@ -11,31 +11,54 @@ package uuidtypes
 import (
 	// Standard Library Imports
 	"context"
+	"fmt"
+	"time"
 
 	// External Imports
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
 // Ensure Implementation matches the expected interfaces.
 var (
-	_ attr.Value               = UUIDValue{}
-	_ basetypes.StringValuable = UUIDValue{}
+	_ attr.Value                                 = UUIDValue{}
+	_ basetypes.StringValuable                   = UUIDValue{}
+	_ basetypes.StringValuableWithSemanticEquals = UUIDValue{}
+	_ function.ValidateableParameter             = UUIDValue{}
 )
 
 // UUIDValue provides a concrete implementation of a UUIDValue tftypes.Value for the
 // Terraform Plugin framework.
 type UUIDValue struct {
 	basetypes.StringValue
+
+	// uuidType is the UUIDType, including its policy, that created the value.
+	uuidType UUIDType
+}
+
+// uuidValuable is implemented by UUIDValue and, via embedding, the
+// version-constrained value types, such as UUIDv4Value.
+type uuidValuable interface {
+	uuidValue() UUIDValue
+}
+
+// uuidValue returns the UUIDValue, including the policy of the type that
+// created it.
+func (u UUIDValue) uuidValue() UUIDValue {
+	return u
 }
 
 // Type returns the UUIDValue type that created the UUIDValue.
 func (u UUIDValue) Type(_ context.Context) attr.Type {
-	return UUIDType{}
+	return u.uuidType
 }
 
-// Equal returns true if the uuid is semantically equal to the Value passed as
-// an argument.
+// Equal returns true if the uuid is equal to the Value passed as an argument.
+// Values are compared by their string, regardless of the policy of the
+// UUIDType that created them, so a value created by NewUUIDValue is equal to
+// the same string read via a configured UUIDType.
 func (u UUIDValue) Equal(o attr.Value) bool {
 	other, ok := o.(UUIDValue)
 	if !ok {
@@ -44,3 +67,158 @@ func (u UUIDValue) Equal(o attr.Value) bool {
 
 	return u.StringValue.Equal(other.StringValue)
 }
+
+// ValueUUID returns the 16 byte representation of the known UUID value. The
+// value is parsed and validated using the same rules as Validate on the
+// UUIDType that created the value. If the value is null or unknown, a zero
+// value is returned.
+func (u UUIDValue) ValueUUID() ([16]byte, diag.Diagnostics) {
+	if u.IsNull() || u.IsUnknown() {
+		return [16]byte{}, nil
+	}
+
+	out, diags := u.uuidType.validate(u.ValueString())
+	if diags.HasError() {
+		return [16]byte{}, diags
+	}
+
+	return out, diags
+}
+
+// ValueUUIDPointer returns a pointer to the 16 byte representation of the
+// known UUID value. If the value is null or unknown, nil is returned.
+func (u UUIDValue) ValueUUIDPointer() (*[16]byte, diag.Diagnostics) {
+	if u.IsNull() || u.IsUnknown() {
+		return nil, nil
+	}
+
+	out, diags := u.ValueUUID()
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &out, diags
+}
+
+// ValueGUIDBytes returns the Microsoft GUID mixed-endian 16 byte
+// representation of the known UUID value, where the first three fields are
+// little-endian. The value is parsed and validated using the same rules as
+// ValueUUID. If the value is null or unknown, a zero value is returned.
+func (u UUIDValue) ValueGUIDBytes() ([16]byte, diag.Diagnostics) {
+	if u.IsNull() || u.IsUnknown() {
+		return [16]byte{}, nil
+	}
+
+	uuid, diags := u.ValueUUID()
+	if diags.HasError() {
+		return [16]byte{}, diags
+	}
+
+	return GUIDBytes(uuid), diags
+}
+
+// Encode returns the known UUID value in the given textual format, for
+// example, FormatBase64URL. The value is parsed and validated using the same
+// rules as Validate on the UUIDType that created the value. If the value is
+// null or unknown, an empty string is returned.
+func (u UUIDValue) Encode(format Format) (string, diag.Diagnostics) {
+	if u.IsNull() || u.IsUnknown() {
+		return "", nil
+	}
+
+	uuid, diags := u.ValueUUID()
+	if diags.HasError() {
+		return "", diags
+	}
+
+	return Encode(uuid, format), diags
+}
+
+// Timestamp returns the time embedded in the known time-based UUID value,
+// either a Version 1, 6 or 7 UUID using the RFC 9562 variant. Version 1 and
+// Version 6 timestamps have 100-nanosecond precision, while Version 7
+// timestamps have millisecond precision. If the value is null or unknown, a
+// zero time is returned. If the UUID is not time-based, an error diagnostic is
+// returned.
+func (u UUIDValue) Timestamp() (time.Time, diag.Diagnostics) {
+	if u.IsNull() || u.IsUnknown() {
+		return time.Time{}, nil
+	}
+
+	uuid, diags := u.ValueUUID()
+	if diags.HasError() {
+		return time.Time{}, diags
+	}
+
+	timestamp, ok := TimestampOf(uuid)
+	if !ok {
+		diags.AddError(
+			"Invalid UUID Timestamp",
+			"A time-based UUID, Version 1, Version 6 or Version 7 using the RFC 9562 variant, was expected, "+
+				fmt.Sprintf("but a %s UUID using the %s variant was provided, which does not contain a timestamp.\n\n", VersionOf(uuid), VariantOf(uuid))+
+				fmt.Sprintf("Provided Value: %q", u.ValueString()),
+		)
+
+		return time.Time{}, diags
+	}
+
+	return timestamp, diags
+}
+
+// ValidateParameter ensures a function argument is a UUID conforming to the
+// policy of the UUIDType that created the value. This enables a function
+// parameter with a UUIDType CustomType to be validated before the function is
+// run, with errors reported against the argument's position.
+func (u UUIDValue) ValidateParameter(ctx context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	resp.Error = u.uuidType.validateParameter(ctx, u.StringValue, req.Position)
+}
+
+// StringSemanticEquals returns true if the given UUID value is semantically
+// equal to the current UUID value. Values are compared by their parsed 16 byte
+// representation, therefore differences in hex character case or textual
+// format, such as surrounding braces, a "urn:uuid:" prefix or omitted hyphens,
+// do not cause a difference.
+func (u UUIDValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(UUIDValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				fmt.Sprintf("Expected Value Type: %T\n", u)+
+				fmt.Sprintf("Got Value Type: %T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	priorUUID, err := parseUUID(u.ValueString(), FormatAll|u.uuidType.Formats, false)
+	if err != nil {
+		diags.Append(semanticEqualityParseError(u.ValueString(), err))
+	}
+
+	newUUID, err := parseUUID(newValue.ValueString(), FormatAll|newValue.uuidType.Formats, false)
+	if err != nil {
+		diags.Append(semanticEqualityParseError(newValue.ValueString(), err))
+	}
+
+	if diags.HasError() {
+		return false, diags
+	}
+
+	return priorUUID == newUUID, diags
+}
+
+// semanticEqualityParseError returns the diagnostic raised when a value being
+// compared for semantic equality can't be parsed as a UUID.
+func semanticEqualityParseError(value string, err error) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Semantic Equality Check Error",
+		"An unexpected error occurred while parsing a UUID value to perform semantic equality checks. "+
+			"Please report this to the provider developers.\n\n"+
+			fmt.Sprintf("Provided Value: %q\n", value)+
+			fmt.Sprintf("Parse Error: %s", err.Error()),
+	)
+}
@@ -16,7 +16,14 @@ import (
 	// External Imports
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
 	// Internal Imports
 	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidtypes"
@@ -29,8 +36,31 @@ const (
 	valueUUIDv3        = "a825d19e-3885-3df7-920a-a3678f53b2ee"
 	valueUUIDv4        = "eb6f148a-6637-4c6b-a4bb-b75b2a1b5a3c"
 	valueUUIDv5        = "f989a266-a679-5f41-92f7-22004c4da817"
+
+	valueUUIDv4Upper  = "EB6F148A-6637-4C6B-A4BB-B75B2A1B5A3C"
+	valueUUIDv4Braced = "{eb6f148a-6637-4c6b-a4bb-b75b2a1b5a3c}"
+	valueUUIDv4URN    = "urn:uuid:eb6f148a-6637-4c6b-a4bb-b75b2a1b5a3c"
+	valueUUIDv4Hex    = "eb6f148a66374c6ba4bbb75b2a1b5a3c"
+
+	valueUUIDv4Base64URL = "628UimY3TGuku7dbKhtaPA"
+	valueUUIDv4Base32    = "5NXRJCTGG5GGXJF3W5NSUG22HQ"
+
+	valueUUIDNil = "00000000-0000-0000-0000-000000000000"
+	valueUUIDMax = "ffffffff-ffff-ffff-ffff-ffffffffffff"
 )
 
+var bytesUUIDv4 = [16]byte{
+	0xeb, 0x6f, 0x14, 0x8a, 0x66, 0x37, 0x4c, 0x6b,
+	0xa4, 0xbb, 0xb7, 0x5b, 0x2a, 0x1b, 0x5a, 0x3c,
+}
+
+// uuidValueFromType returns a known UUIDValue created by the given UUIDType.
+func uuidValueFromType(uuidType uuidtypes.UUIDType, value string) uuidtypes.UUIDValue {
+	valuable, _ := uuidType.ValueFromString(context.Background(), basetypes.NewStringValue(value))
+
+	return valuable.(uuidtypes.UUIDValue)
+}
+
 func TestUUIDValue_Equal(t *testing.T) {
 	t.Parallel()
 
@@ -141,6 +171,18 @@ func TestUUIDValue_Equal(t *testing.T) {
 			other:    types.StringValue(valueUUIDv4),
 			expected: false,
 		},
+		{
+			name:     "value-different-type-policy",
+			value:    uuidtypes.NewUUIDValue(valueUUIDv4),
+			other:    uuidValueFromType(uuidtypes.UUIDType{DisallowNil: true}, valueUUIDv4),
+			expected: true,
+		},
+		{
+			name:     "value-same-type-policy",
+			value:    uuidValueFromType(uuidtypes.UUIDType{DisallowNil: true}, valueUUIDv4),
+			other:    uuidValueFromType(uuidtypes.UUIDType{DisallowNil: true}, valueUUIDv4),
+			expected: true,
+		},
 	}
 
 	for _, testcase := range tests {
@@ -195,3 +237,544 @@ func TestUUIDValue_Type(t *testing.T) {
 		})
 	}
 }
+
+func TestUUIDValue_StringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		value         uuidtypes.UUIDValue
+		other         basetypes.StringValuable
+		expected      bool
+		expectedDiags diag.Diagnostics
+	}{
+		{
+			name:     "value-value",
+			value:    uuidtypes.NewUUIDValue(valueUUIDv4),
+			other:    uuidtypes.NewUUIDValue(valueUUIDv4),
+			expected: true,
+		},
+		{
+			name:     "value-different-value",
+			value:    uuidtypes.NewUUIDValue(valueUUIDv4),
+			other:    uuidtypes.NewUUIDValue(valueUUIDv5),
+			expected: false,
+		},
+		{
+			name:     "value-uppercase",
+			value:    uuidtypes.NewUUIDValue(valueUUIDv4),
+			other:    uuidtypes.NewUUIDValue(valueUUIDv4Upper),
+			expected: true,
+		},
+		{
+			name:     "uppercase-value",
+			value:    uuidtypes.NewUUIDValue(valueUUIDv4Upper),
+			other:    uuidtypes.NewUUIDValue(valueUUIDv4),
+			expected: true,
+		},
+		{
+			name:     "value-braced",
+			value:    uuidtypes.NewUUIDValue(valueUUIDv4),
+			other:    uuidtypes.NewUUIDValue(valueUUIDv4Braced),
+			expected: true,
+		},
+		{
+			name:     "value-urn",
+			value:    uuidtypes.NewUUIDValue(valueUUIDv4),
+			other:    uuidtypes.NewUUIDValue(valueUUIDv4URN),
+			expected: true,
+		},
+		{
+			name:     "value-hex",
+			value:    uuidtypes.NewUUIDValue(valueUUIDv4),
+			other:    uuidtypes.NewUUIDValue(valueUUIDv4Hex),
+			expected: true,
+		},
+		{
+			name:     "value-urn-uppercase",
+			value:    uuidtypes.NewUUIDValue(valueUUIDv4),
+			other:    uuidtypes.NewUUIDValue("URN:UUID:" + valueUUIDv4Upper),
+			expected: true,
+		},
+		{
+			name:     "braced-different-value",
+			value:    uuidtypes.NewUUIDValue(valueUUIDv4Braced),
+			other:    uuidtypes.NewUUIDValue(valueUUIDv5),
+			expected: false,
+		},
+		{
+			name:     "value-base64url-accepted",
+			value:    uuidtypes.NewUUIDValue(valueUUIDv4),
+			other:    uuidValueFromType(uuidtypes.UUIDType{Formats: uuidtypes.FormatBase64URL}, valueUUIDv4Base64URL),
+			expected: true,
+		},
+		{
+			name:     "value-invalid",
+			value:    uuidtypes.NewUUIDValue(valueUUIDv4),
+			other:    uuidtypes.NewUUIDValue(valueInvalid),
+			expected: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected error occurred while parsing a UUID value to perform semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Provided Value: \"actually-not-04a00-UUID-valueat0all0\"\n"+
+						"Parse Error: invalid hex character: found 't' at offset 2",
+				),
+			},
+		},
+		{
+			name:     "invalid-invalid-length",
+			value:    uuidtypes.NewUUIDValue(valueInvalid),
+			other:    uuidtypes.NewUUIDValue(valueInvalidLength),
+			expected: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected error occurred while parsing a UUID value to perform semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Provided Value: \"actually-not-04a00-UUID-valueat0all0\"\n"+
+						"Parse Error: invalid hex character: found 't' at offset 2",
+				),
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected error occurred while parsing a UUID value to perform semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Provided Value: \"not-a-uuid-at-all\"\n"+
+						"Parse Error: wrong length: expected 36 characters but got 17",
+				),
+			},
+		},
+		{
+			name:     "not-uuidtypes.UUIDValue",
+			value:    uuidtypes.NewUUIDValue(valueUUIDv4),
+			other:    types.StringValue(valueUUIDv4),
+			expected: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: uuidtypes.UUIDValue\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, gotDiags := testcase.value.StringSemanticEquals(context.Background(), testcase.other)
+
+			if got != testcase.expected {
+				t.Errorf("StringSemanticEquals()\ngot     : %v\nexpected: %v\n", got, testcase.expected)
+			}
+
+			if diff := cmp.Diff(gotDiags, testcase.expectedDiags); diff != "" {
+				t.Errorf(
+					"StringSemanticEquals() diag.Diagnostics\ngot     : %v\nexpected: %v\ndiff: %v\n",
+					gotDiags,
+					testcase.expectedDiags,
+					diff,
+				)
+			}
+		})
+	}
+}
+
+func TestUUIDValue_ValueUUID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		value         uuidtypes.UUIDValue
+		expected      [16]byte
+		expectedDiags diag.Diagnostics
+	}{
+		{
+			name:     "null",
+			value:    uuidtypes.NewUUIDNull(),
+			expected: [16]byte{},
+		},
+		{
+			name:     "unknown",
+			value:    uuidtypes.NewUUIDUnknown(),
+			expected: [16]byte{},
+		},
+		{
+			name:     "value",
+			value:    uuidtypes.NewUUIDValue(valueUUIDv4),
+			expected: bytesUUIDv4,
+		},
+		{
+			name:     "value-uppercase",
+			value:    uuidtypes.NewUUIDValue(valueUUIDv4Upper),
+			expected: bytesUUIDv4,
+		},
+		{
+			name:     "value-policy-formats",
+			value:    uuidValueFromType(uuidtypes.UUIDType{Formats: uuidtypes.FormatHex}, valueUUIDv4Hex),
+			expected: bytesUUIDv4,
+		},
+		{
+			name:     "value-policy-versions",
+			value:    uuidValueFromType(uuidtypes.UUIDType{Versions: uuidtypes.NewVersionSet(uuidtypes.Version7)}, valueUUIDv4),
+			expected: [16]byte{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid UUID Version",
+					"A Version 7 UUID was expected, but a Version 4 UUID was provided.\n\n"+
+						"Provided Value: \"eb6f148a-6637-4c6b-a4bb-b75b2a1b5a3c\"",
+				),
+			},
+		},
+		{
+			name:     "value-invalid",
+			value:    uuidtypes.NewUUIDValue(valueInvalid),
+			expected: [16]byte{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid UUID String Value",
+					"An unexpected error occurred attempting to parse a string value that was expected to be a valid UUID format. "+
+						"The expected UUID format is 00000000-0000-0000-0000-000000000000. "+
+						"For example, a Version 4 UUID is of the form 7b16fd41-cc23-4ef7-8aa9-c598350ccd18.\n\n"+
+						"Provided Value: \"actually-not-04a00-UUID-valueat0all0\"\n"+
+						"Parse Error: invalid hex character: found 't' at offset 2\n\n"+
+						"    actually-not-04a00-UUID-valueat0all0\n"+
+						"      ^",
+				),
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, gotDiags := testcase.value.ValueUUID()
+
+			if diff := cmp.Diff(got, testcase.expected); diff != "" {
+				t.Errorf("ValueUUID()\ngot     : %v\nexpected: %v\ndiff    : %s\n", got, testcase.expected, diff)
+			}
+
+			if diff := cmp.Diff(gotDiags, testcase.expectedDiags); diff != "" {
+				t.Errorf(
+					"ValueUUID() diag.Diagnostics\ngot     : %v\nexpected: %v\ndiff: %v\n",
+					gotDiags,
+					testcase.expectedDiags,
+					diff,
+				)
+			}
+		})
+	}
+}
+
+func TestUUIDValue_ValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		value    uuidtypes.UUIDValue
+		position int64
+		expected *function.FuncError
+	}{
+		{
+			name:  "null",
+			value: uuidtypes.NewUUIDNull(),
+		},
+		{
+			name:  "unknown",
+			value: uuidtypes.NewUUIDUnknown(),
+		},
+		{
+			name:  "value",
+			value: uuidtypes.NewUUIDValue(valueUUIDv4),
+		},
+		{
+			name:     "value-invalid",
+			value:    uuidtypes.NewUUIDValue(valueInvalid),
+			position: 1,
+			expected: function.NewArgumentFuncError(
+				1,
+				"Invalid UUID String Value: "+
+					"An unexpected error occurred attempting to parse a string value that was expected to be a valid UUID format. "+
+					"The expected UUID format is 00000000-0000-0000-0000-000000000000. "+
+					"For example, a Version 4 UUID is of the form 7b16fd41-cc23-4ef7-8aa9-c598350ccd18.\n\n"+
+					"Provided Value: \"actually-not-04a00-UUID-valueat0all0\"\n"+
+					"Parse Error: invalid hex character: found 't' at offset 2\n\n"+
+					"    actually-not-04a00-UUID-valueat0all0\n"+
+					"      ^",
+			),
+		},
+		{
+			name:     "value-policy-versions",
+			value:    uuidValueFromType(uuidtypes.UUIDType{Versions: uuidtypes.NewVersionSet(uuidtypes.Version7)}, valueUUIDv4),
+			position: 2,
+			expected: function.NewArgumentFuncError(
+				2,
+				"Invalid UUID Version: "+
+					"A Version 7 UUID was expected, but a Version 4 UUID was provided.\n\n"+
+					"Provided Value: \"eb6f148a-6637-4c6b-a4bb-b75b2a1b5a3c\"",
+			),
+		},
+		{
+			name:  "value-policy-formats",
+			value: uuidValueFromType(uuidtypes.UUIDType{Formats: uuidtypes.FormatHex}, valueUUIDv4Hex),
+		},
+	}
+
+	for _, testcase := range tests {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &function.ValidateParameterResponse{}
+			testcase.value.ValidateParameter(context.Background(), function.ValidateParameterRequest{Position: testcase.position}, resp)
+
+			if diff := cmp.Diff(resp.Error, testcase.expected); diff != "" {
+				t.Errorf("ValidateParameter()\ngot     : %v\nexpected: %v\ndiff    : %s\n", resp.Error, testcase.expected, diff)
+			}
+		})
+	}
+}
+
+func TestUUIDValue_ValueUUIDPointer(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		value         uuidtypes.UUIDValue
+		expected      *[16]byte
+		expectedDiags bool
+	}{
+		{
+			name:     "null",
+			value:    uuidtypes.NewUUIDNull(),
+			expected: nil,
+		},
+		{
+			name:     "unknown",
+			value:    uuidtypes.NewUUIDUnknown(),
+			expected: nil,
+		},
+		{
+			name:     "value",
+			value:    uuidtypes.NewUUIDValue(valueUUIDv4),
+			expected: &bytesUUIDv4,
+		},
+		{
+			name:          "value-invalid",
+			value:         uuidtypes.NewUUIDValue(valueInvalid),
+			expected:      nil,
+			expectedDiags: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, gotDiags := testcase.value.ValueUUIDPointer()
+
+			if diff := cmp.Diff(got, testcase.expected); diff != "" {
+				t.Errorf("ValueUUIDPointer()\ngot     : %v\nexpected: %v\ndiff    : %s\n", got, testcase.expected, diff)
+			}
+
+			if gotDiags.HasError() != testcase.expectedDiags {
+				t.Errorf("ValueUUIDPointer() diag.Diagnostics\ngot     : %v\nexpected error: %v\n", gotDiags, testcase.expectedDiags)
+			}
+		})
+	}
+}
+
+func TestUUIDValue_Encode(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		value         uuidtypes.UUIDValue
+		format        uuidtypes.Format
+		expected      string
+		expectedDiags bool
+	}{
+		{
+			name:   "null",
+			value:  uuidtypes.NewUUIDNull(),
+			format: uuidtypes.FormatBase64URL,
+		},
+		{
+			name:   "unknown",
+			value:  uuidtypes.NewUUIDUnknown(),
+			format: uuidtypes.FormatBase64URL,
+		},
+		{
+			name:     "base64url",
+			value:    uuidtypes.NewUUIDValue(valueUUIDv4),
+			format:   uuidtypes.FormatBase64URL,
+			expected: valueUUIDv4Base64URL,
+		},
+		{
+			name:     "base32",
+			value:    uuidtypes.NewUUIDValue(valueUUIDv4),
+			format:   uuidtypes.FormatBase32,
+			expected: valueUUIDv4Base32,
+		},
+		{
+			name:     "base64url-to-urn",
+			value:    uuidValueFromType(uuidtypes.UUIDType{Formats: uuidtypes.FormatBase64URL}, valueUUIDv4Base64URL),
+			format:   uuidtypes.FormatURN,
+			expected: valueUUIDv4URN,
+		},
+		{
+			name:          "value-invalid",
+			value:         uuidtypes.NewUUIDValue(valueInvalid),
+			format:        uuidtypes.FormatBase64URL,
+			expectedDiags: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, gotDiags := testcase.value.Encode(testcase.format)
+			if got != testcase.expected {
+				t.Errorf("Encode()\ngot     : %v\nexpected: %v\n", got, testcase.expected)
+			}
+
+			if gotDiags.HasError() != testcase.expectedDiags {
+				t.Errorf("Encode() diag.Diagnostics\ngot     : %v\nexpected error: %v\n", gotDiags, testcase.expectedDiags)
+			}
+		})
+	}
+}
+
+func TestUUIDValue_SetAttribute(t *testing.T) {
+	t.Parallel()
+
+	uuidType := uuidtypes.UUIDType{Versions: uuidtypes.NewVersionSet(uuidtypes.Version7)}
+
+	type thing struct {
+		ID uuidtypes.UUIDValue `tfsdk:"id"`
+	}
+
+	tests := []struct {
+		name     string
+		values   []thing
+		expected diag.Diagnostics
+	}{
+		{
+			name: "valid",
+			values: []thing{
+				{ID: uuidtypes.NewUUIDValue(valueUUIDv7)},
+				{ID: uuidValueFromType(uuidType, valueUUIDv7)},
+			},
+		},
+		{
+			name: "policy-value",
+			values: []thing{
+				{ID: uuidValueFromType(uuidType, valueUUIDv7)},
+				{ID: uuidValueFromType(uuidType, valueUUIDv1)},
+			},
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("things").AtListIndex(1).AtName("id"),
+					"Invalid UUID Version",
+					"A Version 7 UUID was expected, but a Version 1 UUID was provided.\n\n"+
+						"Provided Value: \"4ea3c666-4309-11ed-b878-0242ac120002\"",
+				),
+			},
+		},
+		{
+			name: "zero-policy-value",
+			values: []thing{
+				{ID: uuidtypes.NewUUIDValue(valueUUIDv4)},
+			},
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("things").AtListIndex(0).AtName("id"),
+					"Invalid UUID Version",
+					"A Version 7 UUID was expected, but a Version 4 UUID was provided.\n\n"+
+						"Provided Value: \"eb6f148a-6637-4c6b-a4bb-b75b2a1b5a3c\"",
+				),
+			},
+		},
+		{
+			name: "other-policy-value",
+			values: []thing{
+				{ID: uuidtypes.UUIDType{Versions: uuidtypes.NewVersionSet(uuidtypes.Version4)}.NewValue(valueUUIDv4)},
+			},
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("things").AtListIndex(0).AtName("id"),
+					"Invalid UUID Version",
+					"A Version 7 UUID was expected, but a Version 4 UUID was provided.\n\n"+
+						"Provided Value: \"eb6f148a-6637-4c6b-a4bb-b75b2a1b5a3c\"",
+				),
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			state := tfsdk.State{
+				Schema: schema.Schema{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							CustomType: uuidType,
+							Computed:   true,
+						},
+						"things": schema.ListNestedAttribute{
+							Computed: true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										CustomType: uuidType,
+										Computed:   true,
+									},
+								},
+							},
+						},
+					},
+				},
+				Raw: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"id":     tftypes.String,
+						"things": tftypes.List{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{"id": tftypes.String}}},
+					},
+				}, nil),
+			}
+
+			got := state.SetAttribute(context.Background(), path.Root("things"), testcase.values)
+			if diff := cmp.Diff(got, testcase.expected); diff != "" {
+				t.Errorf("SetAttribute()\ngot     : %v\nexpected: %v\ndiff    : %s\n", got, testcase.expected, diff)
+			}
+
+			// The same diagnostics are returned when setting a single value.
+			var expected diag.Diagnostics
+			for _, d := range testcase.expected {
+				expected.Append(diag.WithPath(path.Root("id"), d))
+			}
+
+			got = state.SetAttribute(context.Background(), path.Root("id"), testcase.values[len(testcase.values)-1].ID)
+			if diff := cmp.Diff(got, expected); diff != "" {
+				t.Errorf("SetAttribute()\ngot     : %v\nexpected: %v\ndiff    : %s\n", got, expected, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,504 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidtypes_test
+
+import (
+	// Standard Library Imports
+	"context"
+	"fmt"
+	"testing"
+
+	// External Imports
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidtypes"
+)
+
+// versionedType describes a version-constrained UUID type under test.
+type versionedType struct {
+	version   uuidtypes.Version
+	uuidType  basetypes.StringTypable
+	name      string
+	value     string
+	newValue  func(string) basetypes.StringValuable
+	nullValue basetypes.StringValuable
+}
+
+var versionedTypes = []versionedType{
+	{
+		version:   uuidtypes.Version1,
+		uuidType:  uuidtypes.UUIDv1Type{},
+		name:      "uuidtypes.UUIDv1Type",
+		value:     valueUUIDv1,
+		newValue:  func(s string) basetypes.StringValuable { return uuidtypes.NewUUIDv1Value(s) },
+		nullValue: uuidtypes.NewUUIDv1Null(),
+	},
+	{
+		version:   uuidtypes.Version3,
+		uuidType:  uuidtypes.UUIDv3Type{},
+		name:      "uuidtypes.UUIDv3Type",
+		value:     valueUUIDv3,
+		newValue:  func(s string) basetypes.StringValuable { return uuidtypes.NewUUIDv3Value(s) },
+		nullValue: uuidtypes.NewUUIDv3Null(),
+	},
+	{
+		version:   uuidtypes.Version4,
+		uuidType:  uuidtypes.UUIDv4Type{},
+		name:      "uuidtypes.UUIDv4Type",
+		value:     valueUUIDv4,
+		newValue:  func(s string) basetypes.StringValuable { return uuidtypes.NewUUIDv4Value(s) },
+		nullValue: uuidtypes.NewUUIDv4Null(),
+	},
+	{
+		version:   uuidtypes.Version5,
+		uuidType:  uuidtypes.UUIDv5Type{},
+		name:      "uuidtypes.UUIDv5Type",
+		value:     valueUUIDv5,
+		newValue:  func(s string) basetypes.StringValuable { return uuidtypes.NewUUIDv5Value(s) },
+		nullValue: uuidtypes.NewUUIDv5Null(),
+	},
+	{
+		version:   uuidtypes.Version6,
+		uuidType:  uuidtypes.UUIDv6Type{},
+		name:      "uuidtypes.UUIDv6Type",
+		value:     valueUUIDv6,
+		newValue:  func(s string) basetypes.StringValuable { return uuidtypes.NewUUIDv6Value(s) },
+		nullValue: uuidtypes.NewUUIDv6Null(),
+	},
+	{
+		version:   uuidtypes.Version7,
+		uuidType:  uuidtypes.UUIDv7Type{},
+		name:      "uuidtypes.UUIDv7Type",
+		value:     valueUUIDv7,
+		newValue:  func(s string) basetypes.StringValuable { return uuidtypes.NewUUIDv7Value(s) },
+		nullValue: uuidtypes.NewUUIDv7Null(),
+	},
+	{
+		version:   uuidtypes.Version8,
+		uuidType:  uuidtypes.UUIDv8Type{},
+		name:      "uuidtypes.UUIDv8Type",
+		value:     valueUUIDv8,
+		newValue:  func(s string) basetypes.StringValuable { return uuidtypes.NewUUIDv8Value(s) },
+		nullValue: uuidtypes.NewUUIDv8Null(),
+	},
+}
+
+func TestUUIDVersionedType_Equal(t *testing.T) {
+	t.Parallel()
+
+	for _, typ := range versionedTypes {
+		typ := typ
+
+		t.Run(typ.name, func(t *testing.T) {
+			t.Parallel()
+
+			for _, other := range versionedTypes {
+				expected := typ.version == other.version
+				if got := typ.uuidType.Equal(other.uuidType); got != expected {
+					t.Errorf("Equal(%s)\ngot     : %v\nexpected: %v", other.name, got, expected)
+				}
+			}
+
+			if typ.uuidType.Equal(uuidtypes.UUIDType{}) {
+				t.Errorf("Equal(uuidtypes.UUIDType)\ngot     : true\nexpected: false")
+			}
+
+			if (uuidtypes.UUIDType{}).Equal(typ.uuidType) {
+				t.Errorf("uuidtypes.UUIDType.Equal()\ngot     : true\nexpected: false")
+			}
+		})
+	}
+}
+
+func TestUUIDVersionedType_String(t *testing.T) {
+	t.Parallel()
+
+	for _, typ := range versionedTypes {
+		typ := typ
+
+		t.Run(typ.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := typ.uuidType.String(); got != typ.name {
+				t.Errorf("String()\ngot     : %s\nexpected: %s", got, typ.name)
+			}
+		})
+	}
+}
+
+func TestUUIDVersionedType_Validate(t *testing.T) {
+	t.Parallel()
+
+	for _, typ := range versionedTypes {
+		typ := typ
+
+		t.Run(typ.name, func(t *testing.T) {
+			t.Parallel()
+
+			validator, ok := typ.uuidType.(xattr.TypeWithValidate)
+			if !ok {
+				t.Fatalf("%s does not implement xattr.TypeWithValidate", typ.name)
+			}
+
+			for _, other := range versionedTypes {
+				value := tftypes.NewValue(tftypes.String, other.value)
+
+				var expected diag.Diagnostics
+				if other.version != typ.version {
+					expected = diag.Diagnostics{
+						diag.NewAttributeErrorDiagnostic(
+							path.Root("test"),
+							"Invalid UUID Version",
+							fmt.Sprintf("A Version %d UUID was expected, but a Version %d UUID was provided.\n\n", typ.version, other.version)+
+								fmt.Sprintf("Provided Value: %q", other.value),
+						),
+					}
+				}
+
+				got := validator.Validate(context.Background(), value, path.Root("test"))
+				if diff := cmp.Diff(got, expected); diff != "" {
+					t.Errorf("Validate(%s)\ngot     : %s\nexpected: %s\ndiff    : %s", other.value, got, expected, diff)
+				}
+			}
+
+			for _, value := range []tftypes.Value{
+				tftypes.NewValue(tftypes.String, nil),
+				tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			} {
+				if got := validator.Validate(context.Background(), value, path.Root("test")); got != nil {
+					t.Errorf("Validate(%s)\ngot     : %s\nexpected: nil", value, got)
+				}
+			}
+
+			got := validator.Validate(context.Background(), tftypes.NewValue(tftypes.String, valueInvalid), path.Root("test"))
+			if len(got) != 1 || got[0].Summary() != "Invalid UUID String Value" {
+				t.Errorf("Validate(%s)\ngot     : %s\nexpected: Invalid UUID String Value", valueInvalid, got)
+			}
+		})
+	}
+}
+
+func TestUUIDv4Type_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		value    tftypes.Value
+		expected diag.Diagnostics
+	}{
+		{
+			name:  "variant-ncs",
+			value: tftypes.NewValue(tftypes.String, valueUUIDv4VariantNCS),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid UUID Variant",
+					"A UUID using the RFC 9562 variant was expected, but a UUID using the NCS variant was provided.\n\n"+
+						"Provided Value: \"eb6f148a-6637-4c6b-64bb-b75b2a1b5a3c\"",
+				),
+			},
+		},
+		{
+			name:  "variant-microsoft",
+			value: tftypes.NewValue(tftypes.String, valueUUIDv4VariantMicrosoft),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid UUID Variant",
+					"A UUID using the RFC 9562 variant was expected, but a UUID using the Microsoft variant was provided.\n\n"+
+						"Provided Value: \"eb6f148a-6637-4c6b-c4bb-b75b2a1b5a3c\"",
+				),
+			},
+		},
+		{
+			name:  "version-and-variant",
+			value: tftypes.NewValue(tftypes.String, "eb6f148a-6637-1c6b-e4bb-b75b2a1b5a3c"),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid UUID Version",
+					"A Version 4 UUID was expected, but a Version 1 UUID was provided.\n\n"+
+						"Provided Value: \"eb6f148a-6637-1c6b-e4bb-b75b2a1b5a3c\"",
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid UUID Variant",
+					"A UUID using the RFC 9562 variant was expected, but a UUID using the Future variant was provided.\n\n"+
+						"Provided Value: \"eb6f148a-6637-1c6b-e4bb-b75b2a1b5a3c\"",
+				),
+			},
+		},
+		{
+			name:  "uppercase",
+			value: tftypes.NewValue(tftypes.String, valueUUIDv4Upper),
+		},
+	}
+
+	for _, testcase := range tests {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := uuidtypes.UUIDv4Type{}.Validate(context.Background(), testcase.value, path.Root("test"))
+
+			if diff := cmp.Diff(got, testcase.expected); diff != "" {
+				t.Errorf("Validate()\ngot     : %s\nexpected: %s\ndiff    : %s", got, testcase.expected, diff)
+			}
+		})
+	}
+}
+
+func TestUUIDVersionedType_ValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	for _, typ := range versionedTypes {
+		typ := typ
+
+		t.Run(typ.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := typ.uuidType.ValueFromTerraform(context.Background(), tftypes.NewValue(tftypes.String, typ.value))
+			if err != nil {
+				t.Fatalf("ValueFromTerraform() unexpected error: %v", err)
+			}
+
+			expected := typ.newValue(typ.value)
+			if diff := cmp.Diff(got, expected); diff != "" {
+				t.Errorf("ValueFromTerraform()\ngot     : %v\nexpected: %v\ndiff    : %s\n", got, expected, diff)
+			}
+
+			got, err = typ.uuidType.ValueFromTerraform(context.Background(), tftypes.NewValue(tftypes.String, nil))
+			if err != nil {
+				t.Fatalf("ValueFromTerraform() unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(got, typ.nullValue); diff != "" {
+				t.Errorf("ValueFromTerraform()\ngot     : %v\nexpected: %v\ndiff    : %s\n", got, typ.nullValue, diff)
+			}
+
+			if _, err = typ.uuidType.ValueFromTerraform(context.Background(), tftypes.NewValue(tftypes.Number, 1)); err == nil {
+				t.Errorf("ValueFromTerraform() expected error for tftypes.Number")
+			}
+		})
+	}
+}
+
+func TestUUIDVersionedValue_Type(t *testing.T) {
+	t.Parallel()
+
+	for _, typ := range versionedTypes {
+		typ := typ
+
+		t.Run(typ.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := typ.newValue(typ.value).Type(context.Background())
+			if diff := cmp.Diff(got, typ.uuidType); diff != "" {
+				t.Errorf("Type()\ngot     : %v\nexpected: %v\ndiff    : %v\n", got, typ.uuidType, diff)
+			}
+
+			valueType := typ.uuidType.ValueType(context.Background())
+			if diff := cmp.Diff(valueType.Type(context.Background()), typ.uuidType); diff != "" {
+				t.Errorf("ValueType().Type()\ngot     : %v\nexpected: %v\ndiff    : %v\n", valueType, typ.uuidType, diff)
+			}
+		})
+	}
+}
+
+func TestUUIDVersionedValue_Equal(t *testing.T) {
+	t.Parallel()
+
+	for _, typ := range versionedTypes {
+		typ := typ
+
+		t.Run(typ.name, func(t *testing.T) {
+			t.Parallel()
+
+			value := typ.newValue(typ.value)
+
+			tests := []struct {
+				name     string
+				other    attr.Value
+				expected bool
+			}{
+				{
+					name:     "same-value",
+					other:    typ.newValue(typ.value),
+					expected: true,
+				},
+				{
+					name:     "different-value",
+					other:    typ.newValue(valueUUIDv4VariantNCS),
+					expected: false,
+				},
+				{
+					name:     "null",
+					other:    typ.nullValue,
+					expected: false,
+				},
+				{
+					name:     "uuidtypes.UUIDValue",
+					other:    uuidtypes.NewUUIDValue(typ.value),
+					expected: false,
+				},
+				{
+					name:     "basetypes.StringValue",
+					other:    basetypes.NewStringValue(typ.value),
+					expected: false,
+				},
+			}
+
+			for _, testcase := range tests {
+				if got := value.Equal(testcase.other); got != testcase.expected {
+					t.Errorf("Equal(%s)\ngot     : %v\nexpected: %v\n", testcase.name, got, testcase.expected)
+				}
+			}
+		})
+	}
+}
+
+func TestUUIDVersionedValue_StringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	for _, typ := range versionedTypes {
+		typ := typ
+
+		t.Run(typ.name, func(t *testing.T) {
+			t.Parallel()
+
+			value, ok := typ.newValue(typ.value).(basetypes.StringValuableWithSemanticEquals)
+			if !ok {
+				t.Fatalf("%s value does not implement basetypes.StringValuableWithSemanticEquals", typ.name)
+			}
+
+			got, diags := value.StringSemanticEquals(context.Background(), typ.newValue("{"+typ.value+"}"))
+			if !got || diags.HasError() {
+				t.Errorf("StringSemanticEquals(braced)\ngot     : %v %v\nexpected: true", got, diags)
+			}
+
+			got, diags = value.StringSemanticEquals(context.Background(), typ.newValue(valueUUIDv4VariantNCS))
+			if got || diags.HasError() {
+				t.Errorf("StringSemanticEquals(different)\ngot     : %v %v\nexpected: false", got, diags)
+			}
+
+			got, diags = value.StringSemanticEquals(context.Background(), uuidtypes.NewUUIDValue(typ.value))
+			if got || !diags.HasError() {
+				t.Errorf("StringSemanticEquals(uuidtypes.UUIDValue)\ngot     : %v %v\nexpected: false with error", got, diags)
+			}
+		})
+	}
+}
+
+func TestUUIDVersionedValue_ValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	for _, typ := range versionedTypes {
+		typ := typ
+
+		t.Run(typ.name, func(t *testing.T) {
+			t.Parallel()
+
+			value, ok := typ.newValue(typ.value).(function.ValidateableParameter)
+			if !ok {
+				t.Fatalf("%s value does not implement function.ValidateableParameter", typ.name)
+			}
+
+			resp := &function.ValidateParameterResponse{}
+			value.ValidateParameter(context.Background(), function.ValidateParameterRequest{Position: 0}, resp)
+			if resp.Error != nil {
+				t.Errorf("ValidateParameter()\ngot     : %v\nexpected: <nil>\n", resp.Error)
+			}
+
+			other, ok := typ.newValue(valueUUIDv4VariantNCS).(function.ValidateableParameter)
+			if !ok {
+				t.Fatalf("%s value does not implement function.ValidateableParameter", typ.name)
+			}
+
+			resp = &function.ValidateParameterResponse{}
+			other.ValidateParameter(context.Background(), function.ValidateParameterRequest{Position: 1}, resp)
+			if resp.Error == nil || resp.Error.FunctionArgument == nil || *resp.Error.FunctionArgument != 1 {
+				t.Errorf("ValidateParameter()\ngot     : %v\nexpected: error at argument 1\n", resp.Error)
+			}
+		})
+	}
+}
+
+func TestUUIDVersionedValue_ValueUUID(t *testing.T) {
+	t.Parallel()
+
+	for _, typ := range versionedTypes {
+		typ := typ
+
+		t.Run(typ.name, func(t *testing.T) {
+			t.Parallel()
+
+			otherValue := valueUUIDv1
+			if typ.version == uuidtypes.Version1 {
+				otherValue = valueUUIDv4
+			}
+
+			tests := []struct {
+				name          string
+				value         basetypes.StringValuable
+				expectedError bool
+			}{
+				{
+					name:  "constructor",
+					value: typ.newValue(typ.value),
+				},
+				{
+					name:          "constructor-other-version",
+					value:         typ.newValue(otherValue),
+					expectedError: true,
+				},
+				{
+					name:          "value-from-terraform-other-version",
+					value:         mustVersionedValueFromTerraform(t, typ.uuidType, otherValue),
+					expectedError: true,
+				},
+			}
+
+			for _, testcase := range tests {
+				value, ok := testcase.value.(interface {
+					ValueUUID() ([16]byte, diag.Diagnostics)
+				})
+				if !ok {
+					t.Fatalf("%s value does not implement ValueUUID", typ.name)
+				}
+
+				_, diags := value.ValueUUID()
+				if diags.HasError() != testcase.expectedError {
+					t.Errorf("ValueUUID(%s)\ngot     : %v\nexpected error: %v\n", testcase.name, diags, testcase.expectedError)
+				}
+			}
+		})
+	}
+}
+
+// mustVersionedValueFromTerraform returns the value of the given string read
+// via the version-constrained UUID type.
+func mustVersionedValueFromTerraform(t *testing.T, uuidType basetypes.StringTypable, value string) basetypes.StringValuable {
+	t.Helper()
+
+	got, err := uuidType.ValueFromTerraform(context.Background(), tftypes.NewValue(tftypes.String, value))
+	if err != nil {
+		t.Fatalf("ValueFromTerraform() unexpected error: %v", err)
+	}
+
+	return got.(basetypes.StringValuable)
+}
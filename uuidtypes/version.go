@@ -0,0 +1,170 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidtypes
+
+import (
+	// Standard Library Imports
+	"fmt"
+	"strings"
+)
+
+var (
+	// NilUUID is the special form of UUID with all 128 bits set to zero.
+	NilUUID = [16]byte{}
+
+	// MaxUUID is the special form of UUID with all 128 bits set to one.
+	MaxUUID = [16]byte{
+		0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+		0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	}
+)
+
+// Version is the version of a UUID, stored in the most significant 4 bits of
+// octet 6.
+type Version uint8
+
+const (
+	// Version1 is a Gregorian time-based UUID.
+	Version1 Version = 1
+	// Version2 is a DCE Security UUID.
+	Version2 Version = 2
+	// Version3 is a name-based UUID using MD5 hashing.
+	Version3 Version = 3
+	// Version4 is a randomly generated UUID.
+	Version4 Version = 4
+	// Version5 is a name-based UUID using SHA-1 hashing.
+	Version5 Version = 5
+	// Version6 is a field-compatible reordering of a Version 1 UUID.
+	Version6 Version = 6
+	// Version7 is a Unix Epoch time-based UUID.
+	Version7 Version = 7
+	// Version8 is a custom, vendor-specific UUID.
+	Version8 Version = 8
+)
+
+// String returns a human-friendly name of the version, for example,
+// "Version 4".
+func (v Version) String() string {
+	return fmt.Sprintf("Version %d", uint8(v))
+}
+
+// Variant is the variant of a UUID, stored in the most significant bits of
+// octet 8. The variant determines the layout of all other bits.
+type Variant uint8
+
+const (
+	// VariantNCS is reserved for NCS backward compatibility.
+	VariantNCS Variant = iota
+	// VariantRFC9562 is the variant specified by RFC 9562.
+	VariantRFC9562
+	// VariantMicrosoft is reserved for Microsoft backward compatibility.
+	VariantMicrosoft
+	// VariantFuture is reserved for future definition.
+	VariantFuture
+)
+
+// VariantRFC4122 is the variant specified by RFC 4122. RFC 9562 obsoletes RFC
+// 4122, retaining the same variant.
+const VariantRFC4122 = VariantRFC9562
+
+// String returns a human-friendly name of the variant.
+func (v Variant) String() string {
+	switch v {
+	case VariantNCS:
+		return "NCS"
+	case VariantRFC9562:
+		return "RFC 9562"
+	case VariantMicrosoft:
+		return "Microsoft"
+	case VariantFuture:
+		return "Future"
+	default:
+		return fmt.Sprintf("Variant(%d)", uint8(v))
+	}
+}
+
+// VersionSet is a set of UUID versions. The set is stored as a bitmask, so
+// remains comparable.
+type VersionSet uint16
+
+// NewVersionSet returns the set of the given versions. As the version of a
+// UUID is 4 bits, versions above 15 can not be matched and are ignored.
+func NewVersionSet(versions ...Version) VersionSet {
+	var set VersionSet
+	for _, version := range versions {
+		if version > 0x0f {
+			continue
+		}
+
+		set |= 1 << version
+	}
+
+	return set
+}
+
+// Contains returns true if the version is in the set.
+func (s VersionSet) Contains(version Version) bool {
+	return version <= 0x0f && s&(1<<version) != 0
+}
+
+// Versions returns the versions in the set, in ascending order.
+func (s VersionSet) Versions() []Version {
+	var versions []Version
+	for version := Version(0); version <= 0x0f; version++ {
+		if s.Contains(version) {
+			versions = append(versions, version)
+		}
+	}
+
+	return versions
+}
+
+// VersionOf returns the version of the given UUID.
+func VersionOf(uuid [16]byte) Version {
+	return Version(uuid[6] >> 4)
+}
+
+// VariantOf returns the variant of the given UUID.
+func VariantOf(uuid [16]byte) Variant {
+	switch {
+	case uuid[8]&0x80 == 0x00:
+		return VariantNCS
+	case uuid[8]&0xc0 == 0x80:
+		return VariantRFC9562
+	case uuid[8]&0xe0 == 0xc0:
+		return VariantMicrosoft
+	default:
+		return VariantFuture
+	}
+}
+
+// versionType returns the policy enforced by the version-constrained UUID
+// types.
+func versionType(version Version) UUIDType {
+	return UUIDType{
+		Versions:    NewVersionSet(version),
+		DisallowNil: true,
+		DisallowMax: true,
+	}
+}
+
+// versionList returns a human-friendly list of versions, for example,
+// "Version 4 or Version 7".
+func versionList(versions []Version) string {
+	names := make([]string, len(versions))
+	for i, version := range versions {
+		names[i] = version.String()
+	}
+
+	if len(names) == 1 {
+		return names[0]
+	}
+
+	return strings.Join(names[:len(names)-1], ", ") + " or " + names[len(names)-1]
+}
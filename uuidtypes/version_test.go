@@ -0,0 +1,217 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidtypes_test
+
+import (
+	// Standard Library Imports
+	"fmt"
+	"testing"
+
+	// External Imports
+	"github.com/google/go-cmp/cmp"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidtypes"
+)
+
+const (
+	valueUUIDv6 = "1ec9414c-232a-6b00-b3c8-9f6bdeced846"
+	valueUUIDv7 = "017f22e2-79b0-7cc3-98c4-dc0c0c07398f"
+	valueUUIDv8 = "2489e9ad-2ee2-8e00-8ec9-32d5f69181c0"
+
+	valueUUIDv4VariantNCS       = "eb6f148a-6637-4c6b-64bb-b75b2a1b5a3c"
+	valueUUIDv4VariantMicrosoft = "eb6f148a-6637-4c6b-c4bb-b75b2a1b5a3c"
+	valueUUIDv4VariantFuture    = "eb6f148a-6637-4c6b-e4bb-b75b2a1b5a3c"
+)
+
+func TestVersionOf(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		value    string
+		expected uuidtypes.Version
+	}{
+		{
+			name:     "v1",
+			value:    valueUUIDv1,
+			expected: uuidtypes.Version1,
+		},
+		{
+			name:     "v3",
+			value:    valueUUIDv3,
+			expected: uuidtypes.Version3,
+		},
+		{
+			name:     "v4",
+			value:    valueUUIDv4,
+			expected: uuidtypes.Version4,
+		},
+		{
+			name:     "v5",
+			value:    valueUUIDv5,
+			expected: uuidtypes.Version5,
+		},
+		{
+			name:     "v6",
+			value:    valueUUIDv6,
+			expected: uuidtypes.Version6,
+		},
+		{
+			name:     "v7",
+			value:    valueUUIDv7,
+			expected: uuidtypes.Version7,
+		},
+		{
+			name:     "v8",
+			value:    valueUUIDv8,
+			expected: uuidtypes.Version8,
+		},
+	}
+
+	for _, testcase := range tests {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			uuid, diags := uuidtypes.NewUUIDValue(testcase.value).ValueUUID()
+			if diags.HasError() {
+				t.Fatalf("ValueUUID() unexpected diagnostics: %v", diags)
+			}
+
+			got := uuidtypes.VersionOf(uuid)
+			if got != testcase.expected {
+				t.Errorf("VersionOf()\ngot     : %v\nexpected: %v\n", got, testcase.expected)
+			}
+
+			if expected := fmt.Sprintf("Version %d", testcase.expected); got.String() != expected {
+				t.Errorf("String()\ngot     : %s\nexpected: %s\n", got.String(), expected)
+			}
+		})
+	}
+}
+
+func TestNewVersionSet(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		versions    []uuidtypes.Version
+		expected    []uuidtypes.Version
+		contains    uuidtypes.Version
+		notContains uuidtypes.Version
+	}{
+		{
+			name:        "empty",
+			versions:    nil,
+			expected:    nil,
+			notContains: uuidtypes.Version4,
+		},
+		{
+			name:        "sorted-and-deduplicated",
+			versions:    []uuidtypes.Version{uuidtypes.Version7, uuidtypes.Version4, uuidtypes.Version7},
+			expected:    []uuidtypes.Version{uuidtypes.Version4, uuidtypes.Version7},
+			contains:    uuidtypes.Version7,
+			notContains: uuidtypes.Version1,
+		},
+		{
+			name:        "out-of-range-ignored",
+			versions:    []uuidtypes.Version{uuidtypes.Version(17), uuidtypes.Version4},
+			expected:    []uuidtypes.Version{uuidtypes.Version4},
+			contains:    uuidtypes.Version4,
+			notContains: uuidtypes.Version1,
+		},
+	}
+
+	for _, testcase := range tests {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			set := uuidtypes.NewVersionSet(testcase.versions...)
+
+			got := set.Versions()
+			if diff := cmp.Diff(got, testcase.expected); diff != "" {
+				t.Errorf("Versions()\ngot     : %v\nexpected: %v\ndiff    : %s\n", got, testcase.expected, diff)
+			}
+
+			if testcase.contains != 0 && !set.Contains(testcase.contains) {
+				t.Errorf("Contains(%d)\ngot     : false\nexpected: true", testcase.contains)
+			}
+
+			if set.Contains(testcase.notContains) {
+				t.Errorf("Contains(%d)\ngot     : true\nexpected: false", testcase.notContains)
+			}
+
+			if set != uuidtypes.NewVersionSet(testcase.expected...) {
+				t.Errorf("NewVersionSet() is not equal to the set of its versions")
+			}
+		})
+	}
+}
+
+func TestVariantOf(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		value          string
+		expected       uuidtypes.Variant
+		expectedString string
+	}{
+		{
+			name:           "ncs",
+			value:          valueUUIDv4VariantNCS,
+			expected:       uuidtypes.VariantNCS,
+			expectedString: "NCS",
+		},
+		{
+			name:           "rfc9562",
+			value:          valueUUIDv4,
+			expected:       uuidtypes.VariantRFC9562,
+			expectedString: "RFC 9562",
+		},
+		{
+			name:           "microsoft",
+			value:          valueUUIDv4VariantMicrosoft,
+			expected:       uuidtypes.VariantMicrosoft,
+			expectedString: "Microsoft",
+		},
+		{
+			name:           "future",
+			value:          valueUUIDv4VariantFuture,
+			expected:       uuidtypes.VariantFuture,
+			expectedString: "Future",
+		},
+	}
+
+	for _, testcase := range tests {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			uuid, diags := uuidtypes.NewUUIDValue(testcase.value).ValueUUID()
+			if diags.HasError() {
+				t.Fatalf("ValueUUID() unexpected diagnostics: %v", diags)
+			}
+
+			got := uuidtypes.VariantOf(uuid)
+			if got != testcase.expected {
+				t.Errorf("VariantOf()\ngot     : %v\nexpected: %v\n", got, testcase.expected)
+			}
+
+			if got.String() != testcase.expectedString {
+				t.Errorf("String()\ngot     : %s\nexpected: %s\n", got.String(), testcase.expectedString)
+			}
+		})
+	}
+}
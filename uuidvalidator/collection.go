@@ -0,0 +1,241 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidvalidator
+
+import (
+	// Standard Library Imports
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	// External Imports
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ validator.List = collectionValidator{}
+	_ validator.Set  = collectionValidator{}
+	_ validator.Map  = collectionValidator{}
+)
+
+// CollectionOptions configures the validation performed over a collection of
+// UUIDs.
+type CollectionOptions struct {
+	// MinItems is the minimum number of elements the collection must contain.
+	// Zero places no lower bound on the number of elements.
+	MinItems int
+
+	// MaxItems is the maximum number of elements the collection may contain.
+	// Zero places no upper bound on the number of elements.
+	MaxItems int
+
+	// AllowDuplicates permits the same UUID to be provided more than once. By
+	// default, UUIDs must be unique once canonicalized, so
+	// EB6F148A-6637-4C6B-A4BB-B75B2A1B5A3C and
+	// eb6f148a-6637-4c6b-a4bb-b75b2a1b5a3c are considered duplicates.
+	AllowDuplicates bool
+}
+
+// collectionElement is an element of a collection with the path to it.
+type collectionElement struct {
+	path  path.Path
+	value attr.Value
+}
+
+// collectionValidator validates that every element of a collection is a UUID,
+// that the UUIDs are unique and that the collection is within size bounds.
+type collectionValidator struct {
+	// kind is the kind of collection being validated, for example, "list".
+	kind    string
+	options CollectionOptions
+}
+
+// Description describes the validation in plain text formatting.
+func (v collectionValidator) Description(_ context.Context) string {
+	var descriptions []string
+	if v.options.AllowDuplicates {
+		descriptions = append(descriptions, fmt.Sprintf("%s elements must be valid UUIDs", v.kind))
+	} else {
+		descriptions = append(descriptions, fmt.Sprintf("%s elements must be valid, unique UUIDs", v.kind))
+	}
+
+	if v.options.MinItems > 0 {
+		descriptions = append(descriptions, fmt.Sprintf("%s must contain at least %s", v.kind, elementCount(v.options.MinItems)))
+	}
+
+	if v.options.MaxItems > 0 {
+		descriptions = append(descriptions, fmt.Sprintf("%s must contain at most %s", v.kind, elementCount(v.options.MaxItems)))
+	}
+
+	return strings.Join(descriptions, ", ")
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v collectionValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateList performs the validation.
+func (v collectionValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	values := req.ConfigValue.Elements()
+	elements := make([]collectionElement, len(values))
+	for i, value := range values {
+		elements[i] = collectionElement{
+			path:  req.Path.AtListIndex(i),
+			value: value,
+		}
+	}
+
+	resp.Diagnostics.Append(v.validate(ctx, req.Path, elements)...)
+}
+
+// ValidateSet performs the validation.
+func (v collectionValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	values := req.ConfigValue.Elements()
+	elements := make([]collectionElement, len(values))
+	for i, value := range values {
+		elements[i] = collectionElement{
+			path:  req.Path.AtSetValue(value),
+			value: value,
+		}
+	}
+
+	resp.Diagnostics.Append(v.validate(ctx, req.Path, elements)...)
+}
+
+// ValidateMap performs the validation over the values of the map.
+func (v collectionValidator) ValidateMap(ctx context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	values := req.ConfigValue.Elements()
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+
+	// Sort the keys so duplicates are reported consistently.
+	slices.Sort(keys)
+
+	elements := make([]collectionElement, len(keys))
+	for i, key := range keys {
+		elements[i] = collectionElement{
+			path:  req.Path.AtMapKey(key),
+			value: values[key],
+		}
+	}
+
+	resp.Diagnostics.Append(v.validate(ctx, req.Path, elements)...)
+}
+
+// validate validates the elements of the collection found at the attribute
+// path.
+func (v collectionValidator) validate(ctx context.Context, attributePath path.Path, elements []collectionElement) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if v.options.MinItems > 0 && len(elements) < v.options.MinItems {
+		addInvalidValueError(&diags, attributePath, fmt.Sprintf("%s must contain at least %s", v.kind, elementCount(v.options.MinItems)), fmt.Sprint(len(elements)))
+	}
+
+	if v.options.MaxItems > 0 && len(elements) > v.options.MaxItems {
+		addInvalidValueError(&diags, attributePath, fmt.Sprintf("%s must contain at most %s", v.kind, elementCount(v.options.MaxItems)), fmt.Sprint(len(elements)))
+	}
+
+	seen := make(map[[16]byte]path.Path, len(elements))
+	for _, element := range elements {
+		stringValuable, ok := element.value.(basetypes.StringValuable)
+		if !ok {
+			diags.AddAttributeError(
+				element.path,
+				"Invalid Validator for Element Type",
+				"An unexpected error occurred while validating a UUID collection attribute. "+
+					"The validator expects string elements, but the collection contains another element type. "+
+					"Please report this to the provider developers.\n\n"+
+					fmt.Sprintf("Element Type: %T", element.value),
+			)
+
+			continue
+		}
+
+		value, valueDiags := stringValuable.ToStringValue(ctx)
+		diags.Append(valueDiags...)
+		if valueDiags.HasError() {
+			continue
+		}
+
+		uuid, ok := parseValue(&diags, element.path, value, acceptedFormats(element.value.Type(ctx)))
+		if !ok || v.options.AllowDuplicates {
+			continue
+		}
+
+		if firstPath, found := seen[uuid]; found {
+			addInvalidValueError(&diags, element.path, fmt.Sprintf("value must not duplicate the UUID at %s", firstPath), value.ValueString())
+
+			continue
+		}
+
+		seen[uuid] = element.path
+	}
+
+	return diags
+}
+
+// elementCount returns the number of elements with the noun pluralized, for
+// example, "1 element" or "2 elements".
+func elementCount(count int) string {
+	if count == 1 {
+		return "1 element"
+	}
+
+	return fmt.Sprintf("%d elements", count)
+}
+
+// List returns a validator which ensures that every element of a list is a
+// valid UUID, with the list constrained by the given options. Errors about
+// individual elements are reported at the path of the element.
+func List(options CollectionOptions) validator.List {
+	return collectionValidator{
+		kind:    "list",
+		options: options,
+	}
+}
+
+// Set returns a validator which ensures that every element of a set is a
+// valid UUID, with the set constrained by the given options. Terraform only
+// ensures set elements are unique as strings, so this validator also detects
+// UUIDs which only differ in format or letter case.
+func Set(options CollectionOptions) validator.Set {
+	return collectionValidator{
+		kind:    "set",
+		options: options,
+	}
+}
+
+// Map returns a validator which ensures that every value of a map is a valid
+// UUID, with the map constrained by the given options. Map keys are not
+// validated.
+func Map(options CollectionOptions) validator.Map {
+	return collectionValidator{
+		kind:    "map",
+		options: options,
+	}
+}
@@ -0,0 +1,421 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidvalidator_test
+
+import (
+	// Standard Library Imports
+	"context"
+	"testing"
+
+	// External Imports
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidtypes"
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidvalidator"
+)
+
+func TestCollection_Description(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		validator validator.Describer
+		expected  string
+	}{
+		{
+			name:      "list",
+			validator: uuidvalidator.List(uuidvalidator.CollectionOptions{}),
+			expected:  "list elements must be valid, unique UUIDs",
+		},
+		{
+			name: "set-bounded",
+			validator: uuidvalidator.Set(uuidvalidator.CollectionOptions{
+				MinItems: 1,
+				MaxItems: 3,
+			}),
+			expected: "set elements must be valid, unique UUIDs, set must contain at least 1 element, set must contain at most 3 elements",
+		},
+		{
+			name: "map-allow-duplicates",
+			validator: uuidvalidator.Map(uuidvalidator.CollectionOptions{
+				AllowDuplicates: true,
+			}),
+			expected: "map elements must be valid UUIDs",
+		},
+	}
+	for _, testcase := range tests {
+		testcase := testcase
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := testcase.validator.Description(context.Background()); got != testcase.expected {
+				t.Errorf("Description()\ngot     : %v\nexpected: %v\n", got, testcase.expected)
+			}
+			if got := testcase.validator.MarkdownDescription(context.Background()); got != testcase.expected {
+				t.Errorf("MarkdownDescription()\ngot     : %v\nexpected: %v\n", got, testcase.expected)
+			}
+		})
+	}
+}
+
+func TestList_ValidateList(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		options  uuidvalidator.CollectionOptions
+		value    types.List
+		expected diag.Diagnostics
+	}{
+		{
+			name:  "null",
+			value: types.ListNull(types.StringType),
+		},
+		{
+			name:  "unknown",
+			value: types.ListUnknown(types.StringType),
+		},
+		{
+			name: "valid",
+			value: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue(valueUUIDv4),
+				types.StringValue(valueUUIDv7),
+				types.StringNull(),
+				types.StringUnknown(),
+			}),
+		},
+		{
+			name: "valid-uuid-type",
+			value: types.ListValueMust(uuidtypes.UUIDType{}, []attr.Value{
+				uuidtypes.NewUUIDValue(valueUUIDv4),
+				uuidtypes.NewUUIDValue(valueUUIDv7),
+			}),
+		},
+		{
+			name: "valid-uuid-type-formats",
+			value: types.ListValueMust(uuidtypes.UUIDType{Formats: uuidtypes.FormatBase64URL}, []attr.Value{
+				uuidtypes.UUIDType{Formats: uuidtypes.FormatBase64URL}.NewValue(valueUUIDv4Base64URL),
+				uuidtypes.UUIDType{Formats: uuidtypes.FormatBase64URL}.NewValue(valueUUIDv7),
+			}),
+		},
+		{
+			name: "invalid-element-format",
+			value: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue(valueUUIDv4Base64URL),
+			}),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtListIndex(0),
+					"Invalid Attribute Value",
+					"Attribute test[0] value must be a valid UUID, got: 628UimY3TGuku7dbKhtaPA\n\n"+
+						"Parse Error: wrong length: expected 36 characters but got 22",
+				),
+			},
+		},
+		{
+			name: "invalid-element",
+			value: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue(valueUUIDv4),
+				types.StringValue(valueInvalid),
+			}),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtListIndex(1),
+					"Invalid Attribute Value",
+					"Attribute test[1] value must be a valid UUID, got: not-a-uuid-at-all\n\n"+
+						"Parse Error: wrong length: expected 36 characters but got 17",
+				),
+			},
+		},
+		{
+			name: "invalid-element-type",
+			value: types.ListValueMust(types.Int64Type, []attr.Value{
+				types.Int64Value(4),
+			}),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtListIndex(0),
+					"Invalid Validator for Element Type",
+					"An unexpected error occurred while validating a UUID collection attribute. "+
+						"The validator expects string elements, but the collection contains another element type. "+
+						"Please report this to the provider developers.\n\n"+
+						"Element Type: basetypes.Int64Value",
+				),
+			},
+		},
+		{
+			name: "duplicate-canonicalized",
+			value: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue(valueUUIDv4),
+				types.StringValue(valueUUIDv7),
+				types.StringValue(valueUUIDv4Upper),
+				types.StringValue(valueUUIDv4URN),
+			}),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtListIndex(2),
+					"Invalid Attribute Value",
+					"Attribute test[2] value must not duplicate the UUID at test[0], got: "+valueUUIDv4Upper,
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtListIndex(3),
+					"Invalid Attribute Value",
+					"Attribute test[3] value must not duplicate the UUID at test[0], got: "+valueUUIDv4URN,
+				),
+			},
+		},
+		{
+			name: "duplicate-allowed",
+			options: uuidvalidator.CollectionOptions{
+				AllowDuplicates: true,
+			},
+			value: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue(valueUUIDv4),
+				types.StringValue(valueUUIDv4Upper),
+			}),
+		},
+		{
+			name: "min-items",
+			options: uuidvalidator.CollectionOptions{
+				MinItems: 2,
+			},
+			value: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue(valueUUIDv4),
+			}),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value",
+					"Attribute test list must contain at least 2 elements, got: 1",
+				),
+			},
+		},
+		{
+			name: "max-items",
+			options: uuidvalidator.CollectionOptions{
+				MaxItems: 1,
+			},
+			value: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue(valueUUIDv4),
+				types.StringValue(valueUUIDv7),
+			}),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value",
+					"Attribute test list must contain at most 1 element, got: 2",
+				),
+			},
+		},
+	}
+	for _, testcase := range tests {
+		testcase := testcase
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.ListRequest{
+				Path:        path.Root("test"),
+				ConfigValue: testcase.value,
+			}
+			resp := &validator.ListResponse{}
+			uuidvalidator.List(testcase.options).ValidateList(context.Background(), req, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testcase.expected); diff != "" {
+				t.Errorf("ValidateList()\ngot     : %v\nexpected: %v\ndiff    : %s\n", resp.Diagnostics, testcase.expected, diff)
+			}
+		})
+	}
+}
+
+func TestSet_ValidateSet(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		options  uuidvalidator.CollectionOptions
+		value    types.Set
+		expected diag.Diagnostics
+	}{
+		{
+			name:  "null",
+			value: types.SetNull(types.StringType),
+		},
+		{
+			name:  "unknown",
+			value: types.SetUnknown(types.StringType),
+		},
+		{
+			name: "valid",
+			value: types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue(valueUUIDv4),
+				types.StringValue(valueUUIDv7),
+			}),
+		},
+		{
+			name: "invalid-element",
+			value: types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue(valueInvalid),
+			}),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtSetValue(types.StringValue(valueInvalid)),
+					"Invalid Attribute Value",
+					"Attribute test[Value(\"not-a-uuid-at-all\")] value must be a valid UUID, got: not-a-uuid-at-all\n\n"+
+						"Parse Error: wrong length: expected 36 characters but got 17",
+				),
+			},
+		},
+		{
+			name: "duplicate-canonicalized",
+			value: types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue(valueUUIDv4),
+				types.StringValue(valueUUIDv4Upper),
+			}),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtSetValue(types.StringValue(valueUUIDv4Upper)),
+					"Invalid Attribute Value",
+					"Attribute test[Value(\""+valueUUIDv4Upper+"\")] value must not duplicate the UUID at test[Value(\""+valueUUIDv4+"\")], got: "+valueUUIDv4Upper,
+				),
+			},
+		},
+		{
+			name: "max-items",
+			options: uuidvalidator.CollectionOptions{
+				MaxItems: 1,
+			},
+			value: types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue(valueUUIDv4),
+				types.StringValue(valueUUIDv7),
+			}),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value",
+					"Attribute test set must contain at most 1 element, got: 2",
+				),
+			},
+		},
+	}
+	for _, testcase := range tests {
+		testcase := testcase
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.SetRequest{
+				Path:        path.Root("test"),
+				ConfigValue: testcase.value,
+			}
+			resp := &validator.SetResponse{}
+			uuidvalidator.Set(testcase.options).ValidateSet(context.Background(), req, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testcase.expected); diff != "" {
+				t.Errorf("ValidateSet()\ngot     : %v\nexpected: %v\ndiff    : %s\n", resp.Diagnostics, testcase.expected, diff)
+			}
+		})
+	}
+}
+
+func TestMap_ValidateMap(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		options  uuidvalidator.CollectionOptions
+		value    types.Map
+		expected diag.Diagnostics
+	}{
+		{
+			name:  "null",
+			value: types.MapNull(types.StringType),
+		},
+		{
+			name:  "unknown",
+			value: types.MapUnknown(types.StringType),
+		},
+		{
+			name: "valid",
+			value: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"a": types.StringValue(valueUUIDv4),
+				"b": types.StringValue(valueUUIDv7),
+			}),
+		},
+		{
+			name: "invalid-element",
+			value: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"a": types.StringValue(valueInvalid),
+			}),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtMapKey("a"),
+					"Invalid Attribute Value",
+					"Attribute test[\"a\"] value must be a valid UUID, got: not-a-uuid-at-all\n\n"+
+						"Parse Error: wrong length: expected 36 characters but got 17",
+				),
+			},
+		},
+		{
+			name: "duplicate-canonicalized",
+			value: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"c": types.StringValue(valueUUIDv4URN),
+				"b": types.StringValue(valueUUIDv4Upper),
+				"a": types.StringValue(valueUUIDv4),
+			}),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtMapKey("b"),
+					"Invalid Attribute Value",
+					"Attribute test[\"b\"] value must not duplicate the UUID at test[\"a\"], got: "+valueUUIDv4Upper,
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtMapKey("c"),
+					"Invalid Attribute Value",
+					"Attribute test[\"c\"] value must not duplicate the UUID at test[\"a\"], got: "+valueUUIDv4URN,
+				),
+			},
+		},
+		{
+			name: "min-items",
+			options: uuidvalidator.CollectionOptions{
+				MinItems: 1,
+			},
+			value: types.MapValueMust(types.StringType, map[string]attr.Value{}),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value",
+					"Attribute test map must contain at least 1 element, got: 0",
+				),
+			},
+		},
+	}
+	for _, testcase := range tests {
+		testcase := testcase
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.MapRequest{
+				Path:        path.Root("test"),
+				ConfigValue: testcase.value,
+			}
+			resp := &validator.MapResponse{}
+			uuidvalidator.Map(testcase.options).ValidateMap(context.Background(), req, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testcase.expected); diff != "" {
+				t.Errorf("ValidateMap()\ngot     : %v\nexpected: %v\ndiff    : %s\n", resp.Diagnostics, testcase.expected, diff)
+			}
+		})
+	}
+}
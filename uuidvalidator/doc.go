@@ -0,0 +1,18 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+// Package uuidvalidator provides schema validators for UUID attributes.
+//
+// The validators implement validator.String, so can be attached to either a
+// plain schema.StringAttribute or one using the uuidtypes.UUIDType custom
+// type. Values are parsed in any of the textual formats defined by
+// uuidtypes.FormatAll, along with any other formats, such as
+// uuidtypes.FormatBase64URL, accepted by the attribute's uuidtypes.UUIDType.
+// Use the uuidtypes.UUIDType policy to constrain the accepted format. Null and
+// unknown values are not validated.
+package uuidvalidator
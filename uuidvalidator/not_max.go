@@ -0,0 +1,55 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidvalidator
+
+import (
+	// Standard Library Imports
+	"context"
+
+	// External Imports
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidtypes"
+)
+
+var _ validator.String = notMaxValidator{}
+
+// notMaxValidator validates that a UUID is not the Max UUID.
+type notMaxValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (v notMaxValidator) Description(_ context.Context) string {
+	return "value must not be the Max UUID"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v notMaxValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (v notMaxValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	uuid, ok := parseConfigValue(ctx, req, resp)
+	if !ok {
+		return
+	}
+
+	if uuid != uuidtypes.MaxUUID {
+		return
+	}
+
+	addInvalidValueError(&resp.Diagnostics, req.Path, v.Description(ctx), req.ConfigValue.ValueString())
+}
+
+// NotMax returns a validator which ensures that any configured UUID is not
+// the Max UUID, ffffffff-ffff-ffff-ffff-ffffffffffff.
+func NotMax() validator.String {
+	return notMaxValidator{}
+}
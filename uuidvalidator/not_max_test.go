@@ -0,0 +1,57 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidvalidator_test
+
+import (
+	// Standard Library Imports
+	"context"
+	"testing"
+
+	// External Imports
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidvalidator"
+)
+
+func TestNotMax_Description(t *testing.T) {
+	t.Parallel()
+
+	v := uuidvalidator.NotMax()
+	expected := "value must not be the Max UUID"
+	if got := v.Description(context.Background()); got != expected {
+		t.Errorf("Description()\ngot     : %v\nexpected: %v\n", got, expected)
+	}
+	if got := v.MarkdownDescription(context.Background()); got != expected {
+		t.Errorf("MarkdownDescription()\ngot     : %v\nexpected: %v\n", got, expected)
+	}
+}
+
+func TestNotMax_ValidateString(t *testing.T) {
+	t.Parallel()
+
+	description := "value must not be the Max UUID"
+	tests := append(commonTestCases(), []validatorTestCase{
+		{
+			name:  "v4",
+			value: types.StringValue(valueUUIDv4),
+		},
+		{
+			name:     "max",
+			value:    types.StringValue(valueUUIDMax),
+			expected: invalidValueDiagnostics(description, valueUUIDMax),
+		},
+		{
+			name:  "nil",
+			value: types.StringValue(valueUUIDNil),
+		},
+	}...)
+
+	runValidatorTests(t, uuidvalidator.NotMax(), tests)
+}
@@ -0,0 +1,76 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidvalidator
+
+import (
+	// Standard Library Imports
+	"context"
+	"fmt"
+	"strings"
+
+	// External Imports
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidtypes"
+)
+
+var _ validator.String = oneOfValidator{}
+
+// oneOfValidator validates that a UUID is one of the given values.
+type oneOfValidator struct {
+	values []string
+}
+
+// Description describes the validation in plain text formatting.
+func (v oneOfValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be one of: [%s]", strings.Join(v.values, ", "))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v oneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (v oneOfValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	uuid, ok := parseConfigValue(ctx, req, resp)
+	if !ok {
+		return
+	}
+
+	found := false
+	for _, value := range v.values {
+		candidate, err := uuidtypes.ParseFormat(value, uuidtypes.FormatAll)
+		if err != nil {
+			addInvalidCandidateError(resp, req, value, err)
+
+			return
+		}
+
+		if candidate == uuid {
+			found = true
+		}
+	}
+
+	if found {
+		return
+	}
+
+	addInvalidValueError(&resp.Diagnostics, req.Path, v.Description(ctx), req.ConfigValue.ValueString())
+}
+
+// OneOf returns a validator which ensures that any configured UUID is one of the given values.
+// UUIDs are compared by value, so any textual format or letter case may be
+// used.
+func OneOf(values ...string) validator.String {
+	return oneOfValidator{
+		values: values,
+	}
+}
@@ -0,0 +1,96 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidvalidator_test
+
+import (
+	// Standard Library Imports
+	"context"
+	"testing"
+
+	// External Imports
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidvalidator"
+)
+
+func TestOneOf_Description(t *testing.T) {
+	t.Parallel()
+
+	v := uuidvalidator.OneOf(valueUUIDv4Upper, valueUUIDv7)
+	expected := "value must be one of: [EB6F148A-6637-4C6B-A4BB-B75B2A1B5A3C, 017f22e2-79b0-7cc3-98c4-dc0c0c07398f]"
+	if got := v.Description(context.Background()); got != expected {
+		t.Errorf("Description()\ngot     : %v\nexpected: %v\n", got, expected)
+	}
+	if got := v.MarkdownDescription(context.Background()); got != expected {
+		t.Errorf("MarkdownDescription()\ngot     : %v\nexpected: %v\n", got, expected)
+	}
+}
+
+func TestOneOf_ValidateString(t *testing.T) {
+	t.Parallel()
+
+	description := "value must be one of: [EB6F148A-6637-4C6B-A4BB-B75B2A1B5A3C, 017f22e2-79b0-7cc3-98c4-dc0c0c07398f]"
+	tests := append(commonTestCases(), []validatorTestCase{
+		{
+			name:  "v4",
+			value: types.StringValue(valueUUIDv4),
+		},
+		{
+			name:  "v4-upper",
+			value: types.StringValue(valueUUIDv4Upper),
+		},
+		{
+			name:  "v4-urn",
+			value: types.StringValue(valueUUIDv4URN),
+		},
+		{
+			name:  "v7",
+			value: types.StringValue(valueUUIDv7),
+		},
+		{
+			name:     "v1",
+			value:    types.StringValue(valueUUIDv1),
+			expected: invalidValueDiagnostics(description, valueUUIDv1),
+		},
+		{
+			name:     "nil",
+			value:    types.StringValue(valueUUIDNil),
+			expected: invalidValueDiagnostics(description, valueUUIDNil),
+		},
+	}...)
+
+	runValidatorTests(t, uuidvalidator.OneOf(valueUUIDv4Upper, valueUUIDv7), tests)
+}
+
+func TestOneOf_ValidateString_InvalidValidatorValue(t *testing.T) {
+	t.Parallel()
+
+	expected := diag.Diagnostics{
+		diag.NewAttributeErrorDiagnostic(
+			path.Root("test"),
+			"Invalid Validator Configuration",
+			"An unexpected error occurred while validating a UUID attribute. "+
+				"The validator was configured with a value that is not a valid UUID. "+
+				"Please report this to the provider developers.\n\n"+
+				"Validator Value: \"not-a-uuid-at-all\"\n"+
+				"Parse Error: wrong length: expected 36 characters but got 17",
+		),
+	}
+
+	runValidatorTests(t, uuidvalidator.OneOf(valueUUIDv4, valueInvalid), []validatorTestCase{
+		{
+			name:     "v4",
+			value:    types.StringValue(valueUUIDv4),
+			expected: expected,
+		},
+	})
+}
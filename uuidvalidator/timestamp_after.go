@@ -0,0 +1,64 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidvalidator
+
+import (
+	// Standard Library Imports
+	"context"
+	"fmt"
+	"time"
+
+	// External Imports
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidtypes"
+)
+
+var _ validator.String = timestampAfterValidator{}
+
+// timestampAfterValidator validates that the timestamp embedded in a
+// time-based UUID is after the given time.
+type timestampAfterValidator struct {
+	t time.Time
+}
+
+// Description describes the validation in plain text formatting.
+func (v timestampAfterValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be a time-based UUID with a timestamp after %s", v.t.UTC().Format(time.RFC3339Nano))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v timestampAfterValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (v timestampAfterValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	uuid, ok := parseConfigValue(ctx, req, resp)
+	if !ok {
+		return
+	}
+
+	timestamp, ok := uuidtypes.TimestampOf(uuid)
+	if ok && timestamp.After(v.t) {
+		return
+	}
+
+	addInvalidValueError(&resp.Diagnostics, req.Path, v.Description(ctx), req.ConfigValue.ValueString())
+}
+
+// TimestampAfter returns a validator which ensures that any configured UUID
+// is a time-based UUID (Version 1, 6 or 7) with a timestamp strictly after
+// the given time. UUIDs of any other version fail validation.
+func TimestampAfter(t time.Time) validator.String {
+	return timestampAfterValidator{
+		t: t,
+	}
+}
@@ -0,0 +1,69 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidvalidator_test
+
+import (
+	// Standard Library Imports
+	"context"
+	"testing"
+	"time"
+
+	// External Imports
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidvalidator"
+)
+
+func TestTimestampAfter_Description(t *testing.T) {
+	t.Parallel()
+
+	v := uuidvalidator.TimestampAfter(time.Date(2022, time.June, 1, 0, 0, 0, 0, time.UTC))
+	expected := "value must be a time-based UUID with a timestamp after 2022-06-01T00:00:00Z"
+	if got := v.Description(context.Background()); got != expected {
+		t.Errorf("Description()\ngot     : %v\nexpected: %v\n", got, expected)
+	}
+	if got := v.MarkdownDescription(context.Background()); got != expected {
+		t.Errorf("MarkdownDescription()\ngot     : %v\nexpected: %v\n", got, expected)
+	}
+}
+
+func TestTimestampAfter_ValidateString(t *testing.T) {
+	t.Parallel()
+
+	description := "value must be a time-based UUID with a timestamp after 2022-06-01T00:00:00Z"
+	tests := append(commonTestCases(), []validatorTestCase{
+		{
+			name:  "v1",
+			value: types.StringValue(valueUUIDv1),
+		},
+		{
+			name:     "v7",
+			value:    types.StringValue(valueUUIDv7),
+			expected: invalidValueDiagnostics(description, valueUUIDv7),
+		},
+		{
+			name:     "v4",
+			value:    types.StringValue(valueUUIDv4),
+			expected: invalidValueDiagnostics(description, valueUUIDv4),
+		},
+		{
+			name:     "nil",
+			value:    types.StringValue(valueUUIDNil),
+			expected: invalidValueDiagnostics(description, valueUUIDNil),
+		},
+		{
+			name:     "max",
+			value:    types.StringValue(valueUUIDMax),
+			expected: invalidValueDiagnostics(description, valueUUIDMax),
+		},
+	}...)
+
+	runValidatorTests(t, uuidvalidator.TimestampAfter(time.Date(2022, time.June, 1, 0, 0, 0, 0, time.UTC)), tests)
+}
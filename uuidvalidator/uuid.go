@@ -0,0 +1,119 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidvalidator
+
+import (
+	// Standard Library Imports
+	"context"
+	"fmt"
+	"strings"
+
+	// External Imports
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidtypes"
+)
+
+// parseConfigValue parses the known configuration value as a UUID, in any of
+// the formats accepted for the attribute. If the value is null, unknown or not
+// a valid UUID, false is returned. An error diagnostic is added to the
+// response if the value is not a valid UUID.
+func parseConfigValue(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) ([16]byte, bool) {
+	formats := uuidtypes.FormatAll
+	if req.Config.Schema != nil {
+		attributeType, diags := req.Config.Schema.TypeAtPath(ctx, req.Path)
+		if !diags.HasError() {
+			formats = acceptedFormats(attributeType)
+		}
+	}
+
+	return parseValue(&resp.Diagnostics, req.Path, req.ConfigValue, formats)
+}
+
+// acceptedFormats returns the textual formats accepted for a value of the
+// given type. Any format in uuidtypes.FormatAll is accepted, along with the
+// formats a uuidtypes.UUIDType is configured to accept, such as
+// uuidtypes.FormatBase64URL. The encoded formats are otherwise not accepted, as
+// a short identifier could be mistaken for an encoded UUID.
+func acceptedFormats(typ attr.Type) uuidtypes.Format {
+	if uuidType, ok := typ.(uuidtypes.UUIDType); ok {
+		return uuidtypes.FormatAll | uuidType.Formats
+	}
+
+	return uuidtypes.FormatAll
+}
+
+// parseValue parses the known string value found at the attribute path as a
+// UUID in any of the given formats. If the value is null, unknown or not a
+// valid UUID, false is returned. An error diagnostic is added if the value is
+// not a valid UUID.
+func parseValue(diags *diag.Diagnostics, attributePath path.Path, in basetypes.StringValue, formats uuidtypes.Format) ([16]byte, bool) {
+	if in.IsNull() || in.IsUnknown() {
+		return [16]byte{}, false
+	}
+
+	value := in.ValueString()
+	uuid, err := uuidtypes.ParseFormat(value, formats)
+	if err != nil {
+		diags.AddAttributeError(
+			attributePath,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s value must be a valid UUID, got: %s\n\n", attributePath, value)+
+				fmt.Sprintf("Parse Error: %s", err.Error()),
+		)
+
+		return [16]byte{}, false
+	}
+
+	return uuid, true
+}
+
+// addInvalidValueError adds the diagnostic reported when a UUID does not
+// conform to the validator's description.
+func addInvalidValueError(diags *diag.Diagnostics, attributePath path.Path, description string, value string) {
+	diags.AddAttributeError(
+		attributePath,
+		"Invalid Attribute Value",
+		fmt.Sprintf("Attribute %s %s, got: %s", attributePath, description, value),
+	)
+}
+
+// joinOr returns a human-friendly list of the items, for example,
+// "Version 4 or Version 7".
+func joinOr[T fmt.Stringer](items []T) string {
+	names := make([]string, len(items))
+	for i, item := range items {
+		names[i] = item.String()
+	}
+
+	if len(names) <= 1 {
+		return strings.Join(names, "")
+	}
+
+	return strings.Join(names[:len(names)-1], ", ") + " or " + names[len(names)-1]
+}
+
+// addInvalidCandidateError adds the diagnostic reported when a provider has
+// configured a validator with a value that is not a valid UUID.
+func addInvalidCandidateError(resp *validator.StringResponse, req validator.StringRequest, value string, err error) {
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid Validator Configuration",
+		"An unexpected error occurred while validating a UUID attribute. "+
+			"The validator was configured with a value that is not a valid UUID. "+
+			"Please report this to the provider developers.\n\n"+
+			fmt.Sprintf("Validator Value: %q\n", value)+
+			fmt.Sprintf("Parse Error: %s", err.Error()),
+	)
+}
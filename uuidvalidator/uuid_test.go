@@ -0,0 +1,192 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidvalidator_test
+
+import (
+	// Standard Library Imports
+	"context"
+	"testing"
+
+	// External Imports
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidtypes"
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidvalidator"
+)
+
+const (
+	valueUUIDv1 = "4ea3c666-4309-11ed-b878-0242ac120002"
+	valueUUIDv4 = "eb6f148a-6637-4c6b-a4bb-b75b2a1b5a3c"
+	valueUUIDv7 = "017f22e2-79b0-7cc3-98c4-dc0c0c07398f"
+
+	valueUUIDv4Upper = "EB6F148A-6637-4C6B-A4BB-B75B2A1B5A3C"
+	valueUUIDv4URN   = "urn:uuid:eb6f148a-6637-4c6b-a4bb-b75b2a1b5a3c"
+
+	valueUUIDv4Base64URL = "628UimY3TGuku7dbKhtaPA"
+
+	valueUUIDv4VariantMicrosoft = "eb6f148a-6637-4c6b-c4bb-b75b2a1b5a3c"
+
+	valueUUIDNil = "00000000-0000-0000-0000-000000000000"
+	valueUUIDMax = "ffffffff-ffff-ffff-ffff-ffffffffffff"
+
+	valueInvalid = "not-a-uuid-at-all"
+)
+
+// validatorTestCase describes the outcome of running a string validator over
+// a configuration value.
+type validatorTestCase struct {
+	name     string
+	value    types.String
+	expected diag.Diagnostics
+}
+
+// runValidatorTests runs each test case through the given validator.
+func runValidatorTests(t *testing.T, v validator.String, tests []validatorTestCase) {
+	t.Helper()
+
+	for _, testcase := range tests {
+		testcase := testcase
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: testcase.value,
+			}
+			resp := &validator.StringResponse{}
+			v.ValidateString(context.Background(), req, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testcase.expected); diff != "" {
+				t.Errorf("ValidateString()\ngot     : %v\nexpected: %v\ndiff    : %s\n", resp.Diagnostics, testcase.expected, diff)
+			}
+		})
+	}
+}
+
+// invalidValueDiagnostics returns the diagnostics expected when a value does
+// not conform to a validator.
+func invalidValueDiagnostics(description string, value string) diag.Diagnostics {
+	return diag.Diagnostics{
+		diag.NewAttributeErrorDiagnostic(
+			path.Root("test"),
+			"Invalid Attribute Value",
+			"Attribute test "+description+", got: "+value,
+		),
+	}
+}
+
+// commonTestCases returns the test cases every validator shares for null,
+// unknown and unparsable values.
+func commonTestCases() []validatorTestCase {
+	return []validatorTestCase{
+		{
+			name:  "null",
+			value: types.StringNull(),
+		},
+		{
+			name:  "unknown",
+			value: types.StringUnknown(),
+		},
+		{
+			name:  "invalid",
+			value: types.StringValue(valueInvalid),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value",
+					"Attribute test value must be a valid UUID, got: not-a-uuid-at-all\n\n"+
+						"Parse Error: wrong length: expected 36 characters but got 17",
+				),
+			},
+		},
+	}
+}
+
+func TestValidators_AttributeFormats(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		validator validator.String
+		attribute schema.StringAttribute
+		expected  diag.Diagnostics
+	}{
+		{
+			name:      "not-nil-uuid-type-formats",
+			validator: uuidvalidator.NotNil(),
+			attribute: schema.StringAttribute{
+				CustomType: uuidtypes.UUIDType{Formats: uuidtypes.FormatBase64URL},
+				Required:   true,
+			},
+		},
+		{
+			name:      "version-uuid-type-formats",
+			validator: uuidvalidator.Version(uuidtypes.Version4),
+			attribute: schema.StringAttribute{
+				CustomType: uuidtypes.UUIDType{Formats: uuidtypes.FormatBase64URL},
+				Required:   true,
+			},
+		},
+		{
+			name:      "version-uuid-type-other-version",
+			validator: uuidvalidator.Version(uuidtypes.Version7),
+			attribute: schema.StringAttribute{
+				CustomType: uuidtypes.UUIDType{Formats: uuidtypes.FormatBase64URL},
+				Required:   true,
+			},
+			expected: invalidValueDiagnostics("value must be a Version 7 UUID using the RFC 9562 variant", valueUUIDv4Base64URL),
+		},
+		{
+			name:      "not-nil-string",
+			validator: uuidvalidator.NotNil(),
+			attribute: schema.StringAttribute{
+				Required: true,
+			},
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value",
+					"Attribute test value must be a valid UUID, got: "+valueUUIDv4Base64URL+"\n\n"+
+						"Parse Error: wrong length: expected 36 characters but got 22",
+				),
+			},
+		},
+	}
+	for _, testcase := range tests {
+		testcase := testcase
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.StringRequest{
+				Path: path.Root("test"),
+				Config: tfsdk.Config{
+					Schema: schema.Schema{
+						Attributes: map[string]schema.Attribute{
+							"test": testcase.attribute,
+						},
+					},
+				},
+				ConfigValue: types.StringValue(valueUUIDv4Base64URL),
+			}
+			resp := &validator.StringResponse{}
+			testcase.validator.ValidateString(context.Background(), req, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testcase.expected); diff != "" {
+				t.Errorf("ValidateString()\ngot     : %v\nexpected: %v\ndiff    : %s\n", resp.Diagnostics, testcase.expected, diff)
+			}
+		})
+	}
+}
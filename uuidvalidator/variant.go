@@ -0,0 +1,61 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidvalidator
+
+import (
+	// Standard Library Imports
+	"context"
+	"fmt"
+	"slices"
+
+	// External Imports
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidtypes"
+)
+
+var _ validator.String = variantValidator{}
+
+// variantValidator validates that a UUID uses one of the given variants.
+type variantValidator struct {
+	variants []uuidtypes.Variant
+}
+
+// Description describes the validation in plain text formatting.
+func (v variantValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be a UUID using the %s variant", joinOr(v.variants))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v variantValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (v variantValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	uuid, ok := parseConfigValue(ctx, req, resp)
+	if !ok {
+		return
+	}
+
+	if slices.Contains(v.variants, uuidtypes.VariantOf(uuid)) {
+		return
+	}
+
+	addInvalidValueError(&resp.Diagnostics, req.Path, v.Description(ctx), req.ConfigValue.ValueString())
+}
+
+// Variant returns a validator which ensures that any configured UUID uses one
+// of the given variants.
+func Variant(variants ...uuidtypes.Variant) validator.String {
+	return variantValidator{
+		variants: variants,
+	}
+}
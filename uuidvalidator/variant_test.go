@@ -0,0 +1,63 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidvalidator_test
+
+import (
+	// Standard Library Imports
+	"context"
+	"testing"
+
+	// External Imports
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidtypes"
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidvalidator"
+)
+
+func TestVariant_Description(t *testing.T) {
+	t.Parallel()
+
+	v := uuidvalidator.Variant(uuidtypes.VariantMicrosoft, uuidtypes.VariantFuture)
+	expected := "value must be a UUID using the Microsoft or Future variant"
+	if got := v.Description(context.Background()); got != expected {
+		t.Errorf("Description()\ngot     : %v\nexpected: %v\n", got, expected)
+	}
+	if got := v.MarkdownDescription(context.Background()); got != expected {
+		t.Errorf("MarkdownDescription()\ngot     : %v\nexpected: %v\n", got, expected)
+	}
+}
+
+func TestVariant_ValidateString(t *testing.T) {
+	t.Parallel()
+
+	description := "value must be a UUID using the Microsoft or Future variant"
+	tests := append(commonTestCases(), []validatorTestCase{
+		{
+			name:  "v4-variant-microsoft",
+			value: types.StringValue(valueUUIDv4VariantMicrosoft),
+		},
+		{
+			name:  "max",
+			value: types.StringValue(valueUUIDMax),
+		},
+		{
+			name:     "v4",
+			value:    types.StringValue(valueUUIDv4),
+			expected: invalidValueDiagnostics(description, valueUUIDv4),
+		},
+		{
+			name:     "nil",
+			value:    types.StringValue(valueUUIDNil),
+			expected: invalidValueDiagnostics(description, valueUUIDNil),
+		},
+	}...)
+
+	runValidatorTests(t, uuidvalidator.Variant(uuidtypes.VariantMicrosoft, uuidtypes.VariantFuture), tests)
+}
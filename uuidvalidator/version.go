@@ -0,0 +1,61 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidvalidator
+
+import (
+	// Standard Library Imports
+	"context"
+	"fmt"
+	"slices"
+
+	// External Imports
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidtypes"
+)
+
+var _ validator.String = versionValidator{}
+
+// versionValidator validates that a UUID is one of the given versions.
+type versionValidator struct {
+	versions []uuidtypes.Version
+}
+
+// Description describes the validation in plain text formatting.
+func (v versionValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be a %s UUID using the %s variant", joinOr(v.versions), uuidtypes.VariantRFC9562)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v versionValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (v versionValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	uuid, ok := parseConfigValue(ctx, req, resp)
+	if !ok {
+		return
+	}
+
+	if uuidtypes.VariantOf(uuid) == uuidtypes.VariantRFC9562 && slices.Contains(v.versions, uuidtypes.VersionOf(uuid)) {
+		return
+	}
+
+	addInvalidValueError(&resp.Diagnostics, req.Path, v.Description(ctx), req.ConfigValue.ValueString())
+}
+
+// Version returns a validator which ensures that any configured UUID is one
+// of the given versions, using the RFC 9562 variant.
+func Version(versions ...uuidtypes.Version) validator.String {
+	return versionValidator{
+		versions: versions,
+	}
+}
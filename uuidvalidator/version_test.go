@@ -0,0 +1,81 @@
+/*
+ * Copyright (c) 2023 Matthew Hartstonge <matt@mykro.co.nz>
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at http://mozilla.org/MPL/2.0/.
+ */
+
+package uuidvalidator_test
+
+import (
+	// Standard Library Imports
+	"context"
+	"testing"
+
+	// External Imports
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	// Internal Imports
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidtypes"
+	"github.com/matthewhartstonge/terraform-plugin-framework-type-uuid/uuidvalidator"
+)
+
+func TestVersion_Description(t *testing.T) {
+	t.Parallel()
+
+	v := uuidvalidator.Version(uuidtypes.Version4, uuidtypes.Version7)
+	expected := "value must be a Version 4 or Version 7 UUID using the RFC 9562 variant"
+	if got := v.Description(context.Background()); got != expected {
+		t.Errorf("Description()\ngot     : %v\nexpected: %v\n", got, expected)
+	}
+	if got := v.MarkdownDescription(context.Background()); got != expected {
+		t.Errorf("MarkdownDescription()\ngot     : %v\nexpected: %v\n", got, expected)
+	}
+}
+
+func TestVersion_ValidateString(t *testing.T) {
+	t.Parallel()
+
+	description := "value must be a Version 4 or Version 7 UUID using the RFC 9562 variant"
+	tests := append(commonTestCases(), []validatorTestCase{
+		{
+			name:  "v4",
+			value: types.StringValue(valueUUIDv4),
+		},
+		{
+			name:  "v4-upper",
+			value: types.StringValue(valueUUIDv4Upper),
+		},
+		{
+			name:  "v4-urn",
+			value: types.StringValue(valueUUIDv4URN),
+		},
+		{
+			name:  "v7",
+			value: types.StringValue(valueUUIDv7),
+		},
+		{
+			name:     "v1",
+			value:    types.StringValue(valueUUIDv1),
+			expected: invalidValueDiagnostics(description, valueUUIDv1),
+		},
+		{
+			name:     "v4-variant-microsoft",
+			value:    types.StringValue(valueUUIDv4VariantMicrosoft),
+			expected: invalidValueDiagnostics(description, valueUUIDv4VariantMicrosoft),
+		},
+		{
+			name:     "nil",
+			value:    types.StringValue(valueUUIDNil),
+			expected: invalidValueDiagnostics(description, valueUUIDNil),
+		},
+		{
+			name:     "max",
+			value:    types.StringValue(valueUUIDMax),
+			expected: invalidValueDiagnostics(description, valueUUIDMax),
+		},
+	}...)
+
+	runValidatorTests(t, uuidvalidator.Version(uuidtypes.Version4, uuidtypes.Version7), tests)
+}